@@ -0,0 +1,11 @@
+package main
+
+import (
+	"github.com/zephyrtronium/iolang/coreext/addon"
+	"github.com/zephyrtronium/iolang/addons/Morse"
+)
+
+// IoAddon returns an object to load the addon.
+func IoAddon() addon.Interface {
+	return Morse.IoAddon()
+}
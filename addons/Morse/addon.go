@@ -0,0 +1,75 @@
+package Morse
+
+// Code generated by mkaddon; DO NOT EDIT
+
+import (
+	"bytes"
+	"compress/zlib"
+
+	"github.com/zephyrtronium/iolang"
+	"github.com/zephyrtronium/iolang/coreext/addon"
+)
+
+// IoAddon returns a loader for the Morse addon.
+func IoAddon() addon.Interface {
+	return addonMorse{}
+}
+
+type addonMorse struct{}
+
+func (addonMorse) Name() string {
+	return "Morse"
+}
+
+var addonMorseProtos = []string{
+	"Morse",
+}
+
+func (addonMorse) Protos() []string {
+	return addonMorseProtos
+}
+
+var addonMorseDepends []string
+
+func (addonMorse) Depends() []string {
+	return addonMorseDepends
+}
+
+func (addonMorse) Init(vm *iolang.VM) {
+	slotsMorse := iolang.Slots{
+		"decode": vm.NewCFunction(Decode, nil),
+		"encode": vm.NewCFunction(Encode, nil),
+
+		"type": vm.NewString("Morse"),
+	}
+	vm.Install("Morse", vm.ObjectWith(slotsMorse, []*iolang.Object{vm.BaseObject}, nil, nil))
+
+	mergeSequence := iolang.Slots{
+		"asMorse":   vm.NewCFunction(AsMorse, iolang.SequenceTag),
+		"fromMorse": vm.NewCFunction(FromMorse, iolang.SequenceTag),
+	}
+	if obj, ok := vm.GetLocalSlot(vm.Core, "Sequence"); ok {
+		vm.SetSlots(obj, mergeSequence)
+	} else if obj, ok = vm.GetLocalSlot(vm.Addons, "Sequence"); ok {
+		vm.SetSlots(obj, mergeSequence)
+	} else {
+		panic("cannot merge new slots onto Sequence")
+	}
+
+	for i, b := range addonMorseIo {
+		r, err := zlib.NewReader(bytes.NewReader(b))
+		if err != nil {
+			panic(err)
+		}
+		exc, stop := vm.DoReader(r, addonMorseFiles[i])
+		if stop == iolang.ExceptionStop {
+			panic(exc)
+		}
+	}
+}
+
+var addonMorseFiles = []string{"io/morse.io"}
+
+var addonMorseIo = [][]byte{
+	{0x78, 0x9c, 0x6c, 0x8f, 0x41, 0x4e, 0xc4, 0x30, 0xc, 0x45, 0xf7, 0x3d, 0xc5, 0x57, 0x57, 0x20, 0xd, 0xd3, 0x7d, 0xa5, 0x39, 0x2, 0x2b, 0x4e, 0x60, 0x1a, 0xf, 0x89, 0x9a, 0xc6, 0x95, 0x6d, 0x28, 0xdc, 0x1e, 0xa5, 0x69, 0x17, 0xa0, 0xd9, 0xb5, 0xb6, 0xff, 0xfb, 0x2f, 0xaf, 0xa2, 0xc6, 0x8, 0xf2, 0xd4, 0x1, 0xc0, 0x30, 0x20, 0x88, 0x83, 0x4a, 0x40, 0x20, 0x8b, 0x20, 0x65, 0x78, 0x64, 0xd8, 0xcf, 0xf2, 0x2e, 0xd9, 0x70, 0x17, 0x85, 0x45, 0xd1, 0x76, 0x92, 0xa5, 0x7c, 0x60, 0x21, 0x9d, 0xed, 0xba, 0xa7, 0x6b, 0x74, 0x1c, 0x6f, 0xe8, 0xaf, 0x7d, 0xfb, 0xaf, 0x88, 0x7d, 0xf0, 0xd2, 0x9f, 0xf8, 0xcc, 0xee, 0xac, 0x6f, 0xbc, 0x92, 0x92, 0x57, 0x5a, 0xfb, 0x62, 0xdb, 0x7b, 0x26, 0x9, 0x6c, 0x90, 0xfb, 0x71, 0x66, 0xd8, 0x92, 0xc7, 0x54, 0x40, 0xd8, 0x44, 0xc3, 0xa5, 0xb6, 0x9e, 0xa0, 0x3a, 0x78, 0x84, 0xa9, 0xf3, 0xc3, 0xe7, 0x7f, 0xd7, 0xae, 0x82, 0xa6, 0xf2, 0x37, 0xde, 0x36, 0xc3, 0xb1, 0x1b, 0x6, 0x7c, 0x96, 0xb9, 0xc8, 0x56, 0x90, 0x9a, 0x97, 0xf3, 0xb7, 0xc3, 0x5, 0xbc, 0x24, 0x47, 0x2a, 0x58, 0x33, 0x4d, 0x5c, 0x3d, 0xa7, 0x48, 0x4a, 0x53, 0x53, 0x8d, 0x69, 0x8a, 0x88, 0xf4, 0xc5, 0x28, 0xb2, 0xbf, 0xe4, 0x72, 0xd2, 0x44, 0x51, 0x52, 0xae, 0x0, 0x9b, 0xd3, 0x5a, 0x89, 0x4b, 0x33, 0x3c, 0x6b, 0xc6, 0xf1, 0x86, 0x92, 0x72, 0xf7, 0xdc, 0xfd, 0xe, 0x0, 0xe3, 0xbe, 0x7c, 0xed},
+}
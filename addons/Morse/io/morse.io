@@ -0,0 +1,12 @@
+Morse do(
+    // dot and dash are the symbols for short and long marks.
+    dot ::= "."
+    dash ::= "-"
+    // letterSeparator separates the codes of letters within a word, and
+    // wordSeparator separates words.
+    letterSeparator ::= " "
+    wordSeparator ::= " / "
+    // unknown is the text to emit in place of characters which have no code,
+    // or nil to skip them.
+    unknown ::= nil
+)
@@ -0,0 +1,258 @@
+//go:generate go run github.com/zephyrtronium/iolang/cmd/mkaddon addon.yaml addon.go
+//go:generate gofmt -s -w addon.go
+
+// Package Morse converts text to and from International Morse code.
+//
+// The Morse proto holds the symbols used for encoding and decoding: dot, dash,
+// letterSeparator, wordSeparator, and unknown. Clones of Morse can change these
+// to use different symbols. The addon also installs asMorse and fromMorse on
+// Sequence, which use the Morse proto unless another is given.
+package Morse
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/zephyrtronium/iolang"
+
+	. "github.com/zephyrtronium/iolang/addons"
+)
+
+// codes maps letters and digits to their codes in dots and dashes.
+var codes = map[rune]string{
+	'A': ".-", 'B': "-...", 'C': "-.-.", 'D': "-..", 'E': ".", 'F': "..-.",
+	'G': "--.", 'H': "....", 'I': "..", 'J': ".---", 'K': "-.-", 'L': ".-..",
+	'M': "--", 'N': "-.", 'O': "---", 'P': ".--.", 'Q': "--.-", 'R': ".-.",
+	'S': "...", 'T': "-", 'U': "..-", 'V': "...-", 'W': ".--", 'X': "-..-",
+	'Y': "-.--", 'Z': "--..",
+	'0': "-----", '1': ".----", '2': "..---", '3': "...--", '4': "....-",
+	'5': ".....", '6': "-....", '7': "--...", '8': "---..", '9': "----.",
+}
+
+// letters is the inverse of codes.
+var letters = func() map[string]rune {
+	m := make(map[string]rune, len(codes))
+	for r, c := range codes {
+		m[c] = r
+	}
+	return m
+}()
+
+// Symbols is the set of symbols used to write Morse code.
+type Symbols struct {
+	// Dot and Dash are the symbols for short and long marks.
+	Dot, Dash string
+	// Letter separates letters within a word, and Word separates words.
+	Letter, Word string
+	// Unknown replaces characters which have no code. If SkipUnknown is true,
+	// such characters are omitted instead.
+	Unknown     string
+	SkipUnknown bool
+}
+
+// Standard is the conventional set of symbols.
+var Standard = Symbols{Dot: ".", Dash: "-", Letter: " ", Word: " / ", SkipUnknown: true}
+
+// Encode converts text to Morse code. Letters are case-insensitive, and runs
+// of whitespace separate words.
+func (s Symbols) Encode(text string) string {
+	marks := strings.NewReplacer(".", s.Dot, "-", s.Dash)
+	var words []string
+	for _, w := range strings.Fields(text) {
+		var l []string
+		for _, r := range w {
+			c, ok := codes[unicode.ToUpper(r)]
+			if !ok {
+				if !s.SkipUnknown {
+					l = append(l, s.Unknown)
+				}
+				continue
+			}
+			l = append(l, marks.Replace(c))
+		}
+		if len(l) > 0 {
+			words = append(words, strings.Join(l, s.Letter))
+		}
+	}
+	return strings.Join(words, s.Word)
+}
+
+// Decode converts Morse code to uppercase text with words separated by single
+// spaces. If a letter is not a valid code, the returned error is an
+// UnknownCodeError.
+func (s Symbols) Decode(code string) (string, error) {
+	var words []string
+	for _, w := range strings.Split(code, s.Word) {
+		var b strings.Builder
+		for _, c := range strings.Split(w, s.Letter) {
+			if c == "" {
+				continue
+			}
+			r, ok := letters[s.marks(c)]
+			if !ok {
+				return "", UnknownCodeError{c}
+			}
+			b.WriteRune(r)
+		}
+		if b.Len() > 0 {
+			words = append(words, b.String())
+		}
+	}
+	return strings.Join(words, " "), nil
+}
+
+// marks translates a letter written in s's symbols into dots and dashes. If c
+// contains anything other than dots and dashes, the result is not a code.
+func (s Symbols) marks(c string) string {
+	var b strings.Builder
+	for c != "" {
+		switch {
+		case strings.HasPrefix(c, s.Dot):
+			b.WriteByte('.')
+			c = c[len(s.Dot):]
+		case strings.HasPrefix(c, s.Dash):
+			b.WriteByte('-')
+			c = c[len(s.Dash):]
+		default:
+			return ""
+		}
+	}
+	return b.String()
+}
+
+// UnknownCodeError is an error returned when decoding a letter which is not
+// a valid Morse code.
+type UnknownCodeError struct {
+	// Code is the letter which could not be decoded.
+	Code string
+}
+
+func (err UnknownCodeError) Error() string {
+	return "unknown Morse code " + `"` + err.Code + `"`
+}
+
+// SymbolsOf reads the symbols of a Morse object. If any of the symbols is
+// invalid, the result is an exception to raise.
+func SymbolsOf(vm *VM, m *Object) (Symbols, *Object) {
+	var s Symbols
+	for _, slot := range []struct {
+		name string
+		v    *string
+	}{
+		{"dot", &s.Dot},
+		{"dash", &s.Dash},
+		{"letterSeparator", &s.Letter},
+		{"wordSeparator", &s.Word},
+		{"unknown", &s.Unknown},
+	} {
+		r, _ := vm.GetSlot(m, slot.name)
+		if slot.name == "unknown" && (r == nil || r == vm.Nil) {
+			s.SkipUnknown = true
+			continue
+		}
+		if r == nil {
+			return s, vm.NewExceptionf("Morse %s must be a Sequence", slot.name)
+		}
+		r.Lock()
+		v, ok := r.Value.(iolang.Sequence)
+		if ok {
+			*slot.v = v.String()
+		}
+		r.Unlock()
+		if !ok {
+			return s, vm.NewExceptionf("Morse %s must be a Sequence, not %s", slot.name, vm.TypeName(r))
+		}
+	}
+	switch {
+	case s.Dot == "" || s.Dash == "" || s.Letter == "" || s.Word == "":
+		return s, vm.NewExceptionf("Morse symbols must not be empty")
+	case s.Dot == s.Dash:
+		return s, vm.NewExceptionf("Morse dot and dash must differ")
+	case s.Letter == s.Word:
+		return s, vm.NewExceptionf("Morse letter and word separators must differ")
+	}
+	return s, nil
+}
+
+// Encode is a Morse method.
+//
+// encode converts a string to Morse code using the receiver's symbols.
+func Encode(vm *VM, target, locals *Object, msg *Message) *Object {
+	text, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	return encode(vm, target, text)
+}
+
+// Decode is a Morse method.
+//
+// decode converts Morse code written in the receiver's symbols to an
+// uppercase string. Raises an exception if a letter is not a valid code.
+func Decode(vm *VM, target, locals *Object, msg *Message) *Object {
+	code, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	return decode(vm, target, code)
+}
+
+// AsMorse is a Sequence method.
+//
+// asMorse converts the sequence to Morse code, using the symbols of the given
+// Morse object, or of the Morse proto if none is given.
+func AsMorse(vm *VM, target, locals *Object, msg *Message) *Object {
+	m, stop := morseArg(vm, locals, msg)
+	if stop != NoStop {
+		return vm.Stop(m, stop)
+	}
+	return encode(vm, m, seqString(target))
+}
+
+// FromMorse is a Sequence method.
+//
+// fromMorse converts the sequence from Morse code to an uppercase string,
+// using the symbols of the given Morse object, or of the Morse proto if none
+// is given. Raises an exception if a letter is not a valid code.
+func FromMorse(vm *VM, target, locals *Object, msg *Message) *Object {
+	m, stop := morseArg(vm, locals, msg)
+	if stop != NoStop {
+		return vm.Stop(m, stop)
+	}
+	return decode(vm, m, seqString(target))
+}
+
+// morseArg evaluates the optional Morse argument of a Sequence method.
+func morseArg(vm *VM, locals *Object, msg *Message) (*Object, Stop) {
+	if msg.ArgCount() == 0 {
+		return vm.AddonProto("Morse")[0], NoStop
+	}
+	return msg.EvalArgAt(vm, locals, 0)
+}
+
+// seqString returns the string value of a Sequence object.
+func seqString(obj *Object) string {
+	obj.Lock()
+	defer obj.Unlock()
+	return obj.Value.(iolang.Sequence).String()
+}
+
+func encode(vm *VM, m *Object, text string) *Object {
+	s, exc := SymbolsOf(vm, m)
+	if exc != nil {
+		return vm.Stop(exc, ExceptionStop)
+	}
+	return vm.NewString(s.Encode(text))
+}
+
+func decode(vm *VM, m *Object, code string) *Object {
+	s, exc := SymbolsOf(vm, m)
+	if exc != nil {
+		return vm.Stop(exc, ExceptionStop)
+	}
+	r, err := s.Decode(code)
+	if err != nil {
+		return vm.IoError(err)
+	}
+	return vm.NewString(r)
+}
@@ -0,0 +1,70 @@
+package Morse_test
+
+import (
+	"testing"
+
+	"github.com/zephyrtronium/iolang/addons/Morse"
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+// TestSymbols tests encoding and decoding with Go values.
+func TestSymbols(t *testing.T) {
+	code := Morse.Standard.Encode("SOS")
+	if code != "... --- ..." {
+		t.Errorf("wrong encoding of SOS: %q", code)
+	}
+	text, err := Morse.Standard.Decode(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text != "SOS" {
+		t.Errorf("SOS round trip gave %q", text)
+	}
+	if _, err := Morse.Standard.Decode("... ......."); err == nil {
+		t.Error("decoding an unknown code succeeded")
+	}
+}
+
+// TestMorseMethods tests Morse and Sequence methods in Io.
+func TestMorseMethods(t *testing.T) {
+	vm := testutils.VM()
+	<-vm.LoadAddon(Morse.IoAddon())
+	cases := map[string]map[string]testutils.SourceTestCase{
+		"asMorse": {
+			"sos":       {Source: `"SOS" asMorse`, Pass: testutils.PassEqual(vm.NewString("... --- ..."))},
+			"lowercase": {Source: `"sos" asMorse`, Pass: testutils.PassEqual(vm.NewString("... --- ..."))},
+			"words":     {Source: `"hi 42" asMorse`, Pass: testutils.PassEqual(vm.NewString(".... .. / ....- ..---"))},
+			"skip":      {Source: `"a!b" asMorse`, Pass: testutils.PassEqual(vm.NewString(".- -..."))},
+			"replace":   {Source: `"a!b" asMorse(Morse clone setUnknown("?"))`, Pass: testutils.PassEqual(vm.NewString(".- ? -..."))},
+			"symbols":   {Source: `"sos" asMorse(Morse clone setDot("o") setDash("=") setLetterSeparator("|"))`, Pass: testutils.PassEqual(vm.NewString("ooo|===|ooo"))},
+			"empty":     {Source: `"" asMorse`, Pass: testutils.PassEqual(vm.NewString(""))},
+			"badSymbol": {Source: `"sos" asMorse(Morse clone setDot(""))`, Pass: testutils.PassFailure()},
+			"sameMarks": {Source: `"sos" asMorse(Morse clone setDot("-"))`, Pass: testutils.PassFailure()},
+			"notSeq":    {Source: `"sos" asMorse(Morse clone setDash(1))`, Pass: testutils.PassFailure()},
+		},
+		"fromMorse": {
+			"sos":     {Source: `"... --- ..." fromMorse`, Pass: testutils.PassEqual(vm.NewString("SOS"))},
+			"words":   {Source: `".... .. / ....- ..---" fromMorse`, Pass: testutils.PassEqual(vm.NewString("HI 42"))},
+			"symbols": {Source: `"ooo|===|ooo" fromMorse(Morse clone setDot("o") setDash("=") setLetterSeparator("|"))`, Pass: testutils.PassEqual(vm.NewString("SOS"))},
+			"unknown": {Source: `"... ......." fromMorse`, Pass: testutils.PassFailure()},
+			"garbage": {Source: `"abc" fromMorse`, Pass: testutils.PassFailure()},
+		},
+		"roundTrip": {
+			"sos":    {Source: `"SOS" asMorse fromMorse`, Pass: testutils.PassEqual(vm.NewString("SOS"))},
+			"custom": {Source: `Morse clone setDot("·") setDash("−") do(r := "SOS" asMorse(thisContext) fromMorse(thisContext)) r`, Pass: testutils.PassEqual(vm.NewString("SOS"))},
+		},
+		"encode": {
+			"sos": {Source: `Morse encode("SOS")`, Pass: testutils.PassEqual(vm.NewString("... --- ..."))},
+		},
+		"decode": {
+			"sos": {Source: `Morse decode("... --- ...")`, Pass: testutils.PassEqual(vm.NewString("SOS"))},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			for name, s := range c {
+				t.Run(name, s.TestFunc("TestMorseMethods"))
+			}
+		})
+	}
+}
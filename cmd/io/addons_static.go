@@ -8,13 +8,15 @@ package main
 import (
 	"github.com/zephyrtronium/iolang"
 
+	"github.com/zephyrtronium/iolang/addons/Morse"
 	"github.com/zephyrtronium/iolang/addons/Range"
 )
 
-const numStaticAddons = 1
+const numStaticAddons = 2
 
 func setupStaticAddons(vm *iolang.VM) {
 	ch := make(chan struct{}, numStaticAddons)
+	go func() { ch <- <-vm.LoadAddon(Morse.IoAddon()) }()
 	go func() { ch <- <-vm.LoadAddon(Range.IoAddon()) }()
 	for i := 0; i < numStaticAddons; i++ {
 		<-ch
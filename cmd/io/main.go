@@ -3,6 +3,7 @@ package main
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/zephyrtronium/iolang"
@@ -14,7 +15,10 @@ import (
 )
 
 func main() {
-	vm := iolang.NewVM(os.Args[1:]...)
+	if len(os.Args) > 1 {
+		os.Exit(runFile(os.Stderr, os.Args[1], os.Args[2:]))
+	}
+	vm := iolang.NewVM()
 	setupStaticAddons(vm)
 	vm.SetSlots(vm.Lobby, iolang.Slots{
 		"ps1":       vm.NewString("io> "),
@@ -22,7 +26,8 @@ func main() {
 		"isRunning": vm.True,
 		"profiled":  vm.NewCFunction(profiled, nil),
 	})
-	vm.MustDoString(`Lobby setSlot("exit", method(Lobby setSlot("isRunning", false)))`)
+	// exit stops the VM so that hooks registered with OnExit run.
+	vm.MustDoString(`Lobby setSlot("exit", method(Lobby setSlot("isRunning", false); System exit))`)
 
 	stdin := bufio.NewScanner(os.Stdin)
 	for isRunning, _ := vm.GetSlot(vm.Lobby, "isRunning"); vm.IsAlive() && vm.AsBool(isRunning); isRunning, _ = vm.GetSlot(vm.Lobby, "isRunning") {
@@ -39,21 +44,10 @@ func main() {
 		fmt.Print(p)
 		ok := stdin.Scan()
 		x, stop := vm.DoString(stdin.Text(), "Command Line")
+		// Keep buffered output in order with the interpreter's own.
+		vm.Stdout.Flush()
 		if stop == iolang.ExceptionStop {
-			if ex, ok := x.Value.(iolang.Exception); ok {
-				fmt.Println("Exception:")
-				for i := len(ex.Stack) - 1; i >= 0; i-- {
-					m := ex.Stack[i]
-					if m.IsStart() {
-						fmt.Printf("\t%s\t%s:%d\n", m.Name(), m.Label, m.Line)
-					} else {
-						fmt.Printf("\t%s %s\t%s:%d\n", m.Prev.Name(), m.Name(), m.Label, m.Line)
-					}
-				}
-			} else {
-				fmt.Println("Raised as exception:")
-				fmt.Println("\t", vm.AsString(x))
-			}
+			printException(os.Stdout, vm, x)
 		}
 		if !ok || !vm.IsAlive() {
 			break
@@ -61,6 +55,49 @@ func main() {
 		fmt.Println(vm.AsString(x))
 	}
 	fmt.Println(stdin.Err())
+	// Stop the VM if the input ended before exit was called.
+	shutdown(vm)
+}
+
+// runFile executes the Io source file at path with the given System args and
+// returns the process exit status. Uncaught exceptions are described to w.
+func runFile(w io.Writer, path string, args []string) int {
+	vm := iolang.NewVM(args...)
+	setupStaticAddons(vm)
+	vm.SetSlot(vm.Lobby, "profiled", vm.NewCFunction(profiled, nil))
+	x, stop := vm.RunFile(path)
+	// The exception must be described before shutting down, because
+	// converting objects to strings requires a running VM.
+	if stop == iolang.ExceptionStop {
+		printException(w, vm, x)
+	}
+	shutdown(vm)
+	switch stop {
+	case iolang.ExceptionStop:
+		return 1
+	case iolang.ExitStop:
+		return vm.ExitStatus
+	}
+	return 0
+}
+
+// shutdown stops the VM, if it has not already stopped, and waits for its
+// exit hooks to run and its output to be flushed.
+func shutdown(vm *iolang.VM) {
+	vm.Sched.Exit(0)
+	<-vm.Sched.Alive
+}
+
+// printException describes a raised object, including its stack if it is an
+// Exception.
+func printException(w io.Writer, vm *iolang.VM, x *iolang.Object) {
+	if ex, ok := x.Value.(iolang.Exception); ok {
+		fmt.Fprintln(w, "Exception:", ex.Error())
+		ex.WriteStack(w)
+	} else {
+		fmt.Fprintln(w, "Raised as exception:")
+		fmt.Fprintln(w, "\t", vm.AsString(x))
+	}
 }
 
 func profiled(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestRunFileException tests that scripts which fail to open or which raise
+// exit with status 1 and describe the exception.
+func TestRunFileException(t *testing.T) {
+	dir, err := ioutil.TempDir("", "iolang-cmd-io")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	raises := filepath.Join(dir, "raises.io")
+	if err := ioutil.WriteFile(raises, []byte(`Exception raise("boom")`+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cases := map[string]struct {
+		path string
+		want string
+	}{
+		"missing": {filepath.Join(dir, "missing.io"), "missing.io"},
+		"raises":  {raises, "Exception: boom"},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var b bytes.Buffer
+			if status := runFile(&b, c.path, nil); status != 1 {
+				t.Errorf("wrong exit status: want 1, got %d", status)
+			}
+			if !strings.Contains(b.String(), c.want) {
+				t.Errorf("output %q does not contain %q", b.String(), c.want)
+			}
+		})
+	}
+}
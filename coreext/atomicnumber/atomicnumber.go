@@ -0,0 +1,120 @@
+package atomicnumber
+
+import (
+	"sync/atomic"
+
+	"github.com/zephyrtronium/iolang"
+	"github.com/zephyrtronium/iolang/internal"
+)
+
+// tagAtomicNumber is the Tag type for AtomicNumber objects.
+type tagAtomicNumber struct{}
+
+func (tagAtomicNumber) Activate(vm *iolang.VM, self, target, locals, context *iolang.Object, msg *iolang.Message) *iolang.Object {
+	return self
+}
+
+func (tagAtomicNumber) CloneValue(value interface{}) interface{} {
+	x := atomic.LoadInt64(value.(*int64))
+	return &x
+}
+
+func (tagAtomicNumber) String() string {
+	return "AtomicNumber"
+}
+
+// AtomicNumberTag is the Tag for AtomicNumber objects. Activate returns self.
+// CloneValue creates a new counter holding the parent's current value.
+var AtomicNumberTag tagAtomicNumber
+
+// New creates a new AtomicNumber object with the given initial value.
+func New(vm *iolang.VM, x int64) *iolang.Object {
+	return vm.ObjectWith(nil, vm.CoreProto("AtomicNumber"), &x, AtomicNumberTag)
+}
+
+func init() {
+	internal.Register(initAtomicNumber)
+}
+
+func initAtomicNumber(vm *iolang.VM) {
+	slots := iolang.Slots{
+		"addAndGet":     vm.NewCFunction(addAndGet, AtomicNumberTag),
+		"compareAndSet": vm.NewCFunction(compareAndSet, AtomicNumberTag),
+		"decrement":     vm.NewCFunction(decrement, AtomicNumberTag),
+		"get":           vm.NewCFunction(get, AtomicNumberTag),
+		"increment":     vm.NewCFunction(increment, AtomicNumberTag),
+		"set":           vm.NewCFunction(set, AtomicNumberTag),
+		"type":          vm.NewString("AtomicNumber"),
+	}
+	slots["asNumber"] = slots["get"]
+	internal.CoreInstall(vm, "AtomicNumber", slots, new(int64), AtomicNumberTag)
+}
+
+// addAndGet is an AtomicNumber method.
+//
+// addAndGet atomically adds the argument, truncated to an integer, to the
+// counter and returns the new value.
+func addAndGet(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	n, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != iolang.NoStop {
+		return vm.Stop(exc, stop)
+	}
+	p := target.Value.(*int64)
+	return vm.NewNumber(float64(atomic.AddInt64(p, int64(n))))
+}
+
+// compareAndSet is an AtomicNumber method.
+//
+// compareAndSet atomically sets the counter to the second argument if its
+// current value is equal to the first, returning whether the swap occurred.
+func compareAndSet(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	old, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != iolang.NoStop {
+		return vm.Stop(exc, stop)
+	}
+	nw, exc, stop := msg.NumberArgAt(vm, locals, 1)
+	if stop != iolang.NoStop {
+		return vm.Stop(exc, stop)
+	}
+	p := target.Value.(*int64)
+	return vm.IoBool(atomic.CompareAndSwapInt64(p, int64(old), int64(nw)))
+}
+
+// decrement is an AtomicNumber method.
+//
+// decrement atomically subtracts one from the counter and returns the new
+// value.
+func decrement(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	p := target.Value.(*int64)
+	return vm.NewNumber(float64(atomic.AddInt64(p, -1)))
+}
+
+// get is an AtomicNumber method.
+//
+// get atomically loads the counter's value.
+func get(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	p := target.Value.(*int64)
+	return vm.NewNumber(float64(atomic.LoadInt64(p)))
+}
+
+// increment is an AtomicNumber method.
+//
+// increment atomically adds one to the counter and returns the new value.
+func increment(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	p := target.Value.(*int64)
+	return vm.NewNumber(float64(atomic.AddInt64(p, 1)))
+}
+
+// set is an AtomicNumber method.
+//
+// set atomically stores the argument, truncated to an integer, as the
+// counter's value.
+func set(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	n, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != iolang.NoStop {
+		return vm.Stop(exc, stop)
+	}
+	p := target.Value.(*int64)
+	atomic.StoreInt64(p, int64(n))
+	return target
+}
@@ -0,0 +1,63 @@
+package atomicnumber_test
+
+import (
+	"testing"
+
+	_ "github.com/zephyrtronium/iolang/coreext/atomicnumber" // side effects
+	_ "github.com/zephyrtronium/iolang/coreext/future"       // futureSend
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+func TestRegister(t *testing.T) {
+	testutils.CheckNewSlots(t, testutils.VM().Core, []string{"AtomicNumber"})
+}
+
+func TestAtomicNumberMethods(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]map[string]testutils.SourceTestCase{
+		"addAndGet": {
+			"add":      {Source: `AtomicNumber clone set(2) addAndGet(3)`, Pass: testutils.PassEqual(vm.NewNumber(5))},
+			"negative": {Source: `AtomicNumber clone set(2) addAndGet(-5)`, Pass: testutils.PassEqual(vm.NewNumber(-3))},
+			"truncate": {Source: `AtomicNumber clone set(0) addAndGet(2.9)`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"stores":   {Source: `AtomicNumber clone set(1) do(addAndGet(4)) get`, Pass: testutils.PassEqual(vm.NewNumber(5))},
+			"notNum":   {Source: `AtomicNumber clone addAndGet("a")`, Pass: testutils.PassFailure()},
+		},
+		"compareAndSet": {
+			"swap":     {Source: `AtomicNumber clone set(1) compareAndSet(1, 7)`, Pass: testutils.PassIdentical(vm.True)},
+			"swapped":  {Source: `AtomicNumber clone set(1) do(compareAndSet(1, 7)) get`, Pass: testutils.PassEqual(vm.NewNumber(7))},
+			"mismatch": {Source: `AtomicNumber clone set(1) compareAndSet(2, 7)`, Pass: testutils.PassIdentical(vm.False)},
+			"kept":     {Source: `AtomicNumber clone set(1) do(compareAndSet(2, 7)) get`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"notNum":   {Source: `AtomicNumber clone compareAndSet(0, "a")`, Pass: testutils.PassFailure()},
+		},
+		"increment": {
+			"increment": {Source: `AtomicNumber clone set(1) increment`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"decrement": {Source: `AtomicNumber clone set(1) decrement`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+		},
+		"get": {
+			"zero":     {Source: `AtomicNumber clone get`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"asNumber": {Source: `AtomicNumber clone set(3) asNumber`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+		},
+		"set": {
+			"set":      {Source: `AtomicNumber clone set(4) get`, Pass: testutils.PassEqual(vm.NewNumber(4))},
+			"truncate": {Source: `AtomicNumber clone set(-4.5) get`, Pass: testutils.PassEqual(vm.NewNumber(-4))},
+			"self":     {Source: `Object clone do(a := AtomicNumber clone; r := a set(1) isIdenticalTo(a)) r`, Pass: testutils.PassIdentical(vm.True)},
+			"notNum":   {Source: `AtomicNumber clone set("a")`, Pass: testutils.PassFailure()},
+		},
+		"clone": {
+			"copies":   {Source: `AtomicNumber clone set(5) clone get`, Pass: testutils.PassEqual(vm.NewNumber(5))},
+			"separate": {Source: `Object clone do(a := AtomicNumber clone set(5); b := a clone; b increment; r := a get) r`, Pass: testutils.PassEqual(vm.NewNumber(5))},
+		},
+		"concurrent": {
+			"increment":     {Source: `Object clone do(a := AtomicNumber clone; fs := list(1, 2, 3, 4) map(v, futureSend(100 repeat(a increment))); fs foreach(waitOnResult); r := a get) r`, Pass: testutils.PassEqual(vm.NewNumber(400))},
+			"addAndGet":     {Source: `Object clone do(a := AtomicNumber clone; fs := list(1, 2, 3, 4) map(v, futureSend(50 repeat(a addAndGet(3); a decrement))); fs foreach(waitOnResult); r := a get) r`, Pass: testutils.PassEqual(vm.NewNumber(400))},
+			"compareAndSet": {Source: `Object clone do(a := AtomicNumber clone; fs := list(1, 2, 3, 4) map(v, futureSend(100 repeat(loop(x := a get; if(a compareAndSet(x, x + 2), break))))); fs foreach(waitOnResult); r := a get) r`, Pass: testutils.PassEqual(vm.NewNumber(800))},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			for name, s := range c {
+				t.Run(name, s.TestFunc("TestAtomicNumberMethods"))
+			}
+		})
+	}
+}
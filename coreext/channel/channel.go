@@ -0,0 +1,206 @@
+package channel
+
+import (
+	"math"
+	"sync"
+
+	"github.com/zephyrtronium/iolang"
+	"github.com/zephyrtronium/iolang/internal"
+)
+
+// A Channel is a buffered queue of objects for communicating between
+// coroutines.
+type Channel struct {
+	// C is the channel carrying values. It is never closed, so that senders
+	// blocked on it cannot panic when the Channel closes.
+	C chan *iolang.Object
+	// done is closed when the Channel closes.
+	done chan struct{}
+	// once ensures done is closed only once.
+	once sync.Once
+}
+
+// maxCapacity is the largest buffer capacity that Channel with accepts.
+const maxCapacity = 1 << 24
+
+// NewChannel creates a new Channel value with the given buffer capacity.
+func NewChannel(capacity int) *Channel {
+	return &Channel{
+		C:    make(chan *iolang.Object, capacity),
+		done: make(chan struct{}),
+	}
+}
+
+// Close closes the channel. It is safe to call multiple times.
+func (c *Channel) Close() {
+	c.once.Do(func() { close(c.done) })
+}
+
+// IsClosed returns whether the channel has been closed.
+func (c *Channel) IsClosed() bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Send sends a value on the channel, blocking until there is room for it.
+// While blocked, the channel monitors the coroutine's remote control flow
+// channel, and any Stop received is returned. If the Channel is or becomes
+// closed before the value is sent, the result is an exception.
+func (c *Channel) Send(vm *iolang.VM, v *iolang.Object) (*iolang.Object, iolang.Stop) {
+	if c.IsClosed() {
+		return vm.NewExceptionf("send on closed Channel"), iolang.ExceptionStop
+	}
+	for {
+		select {
+		case c.C <- v:
+			return v, iolang.NoStop
+		case <-c.done:
+			return vm.NewExceptionf("send on closed Channel"), iolang.ExceptionStop
+		case stop := <-vm.Control:
+			if r, s := vm.HandleRemoteStop(stop, nil); s != iolang.NoStop {
+				return r, s
+			}
+		}
+	}
+}
+
+// Receive receives a value from the channel, blocking until one is ready.
+// While blocked, the channel monitors the coroutine's remote control flow
+// channel, and any Stop received is returned. If the Channel is closed and
+// empty, the result is nil.
+func (c *Channel) Receive(vm *iolang.VM) (*iolang.Object, iolang.Stop) {
+	for {
+		select {
+		case v := <-c.C:
+			return v, iolang.NoStop
+		case <-c.done:
+			// Drain any values sent before the close.
+			select {
+			case v := <-c.C:
+				return v, iolang.NoStop
+			default:
+				return vm.Nil, iolang.NoStop
+			}
+		case stop := <-vm.Control:
+			if r, s := vm.HandleRemoteStop(stop, nil); s != iolang.NoStop {
+				return r, s
+			}
+		}
+	}
+}
+
+// tagChannel is the Tag type for Channel objects.
+type tagChannel struct{}
+
+func (tagChannel) Activate(vm *iolang.VM, self, target, locals, context *iolang.Object, msg *iolang.Message) *iolang.Object {
+	return self
+}
+
+func (tagChannel) CloneValue(value interface{}) interface{} {
+	return NewChannel(cap(value.(*Channel).C))
+}
+
+func (tagChannel) String() string {
+	return "Channel"
+}
+
+// ChannelTag is the Tag for Channel objects. Activate returns self.
+// CloneValue creates a new, open Channel with the same capacity as the parent.
+var ChannelTag tagChannel
+
+// New creates a new Channel object with the given buffer capacity.
+func New(vm *iolang.VM, capacity int) *iolang.Object {
+	return vm.ObjectWith(nil, vm.CoreProto("Channel"), NewChannel(capacity), ChannelTag)
+}
+
+func init() {
+	internal.Register(initChannel)
+}
+
+func initChannel(vm *iolang.VM) {
+	slots := iolang.Slots{
+		"capacity": vm.NewCFunction(capacity, ChannelTag),
+		"close":    vm.NewCFunction(closeChannel, ChannelTag),
+		"isClosed": vm.NewCFunction(isClosed, ChannelTag),
+		"receive":  vm.NewCFunction(receive, ChannelTag),
+		"send":     vm.NewCFunction(send, ChannelTag),
+		"size":     vm.NewCFunction(size, ChannelTag),
+		"type":     vm.NewString("Channel"),
+		"with":     vm.NewCFunction(with, nil),
+	}
+	internal.CoreInstall(vm, "Channel", slots, NewChannel(0), ChannelTag)
+}
+
+// capacity is a Channel method.
+//
+// capacity returns the number of values the channel can buffer.
+func capacity(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	c := target.Value.(*Channel)
+	return vm.NewNumber(float64(cap(c.C)))
+}
+
+// closeChannel is a Channel method.
+//
+// close closes the channel. Values already sent remain available to receive,
+// but further sends raise an exception.
+func closeChannel(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	target.Value.(*Channel).Close()
+	return target
+}
+
+// isClosed is a Channel method.
+//
+// isClosed returns whether the channel has been closed.
+func isClosed(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	return vm.IoBool(target.Value.(*Channel).IsClosed())
+}
+
+// receive is a Channel method.
+//
+// receive returns the next value sent on the channel, waiting until one is
+// available. If the channel is closed and empty, the result is nil.
+func receive(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	return vm.Stop(target.Value.(*Channel).Receive(vm))
+}
+
+// send is a Channel method.
+//
+// send adds a value to the channel, waiting until there is room for it. It is
+// an error to send on a closed channel.
+func send(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	v, stop := msg.EvalArgAt(vm, locals, 0)
+	if stop != iolang.NoStop {
+		return vm.Stop(v, stop)
+	}
+	if r, stop := target.Value.(*Channel).Send(vm, v); stop != iolang.NoStop {
+		return vm.Stop(r, stop)
+	}
+	return target
+}
+
+// size is a Channel method.
+//
+// size returns the number of values currently buffered in the channel.
+func size(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	c := target.Value.(*Channel)
+	return vm.NewNumber(float64(len(c.C)))
+}
+
+// with is a Channel method.
+//
+// with creates a new Channel with the given buffer capacity, which must be an
+// integer between 0 and 2^24.
+func with(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	n, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != iolang.NoStop {
+		return vm.Stop(exc, stop)
+	}
+	if !(n >= 0 && n <= maxCapacity) || n != math.Trunc(n) {
+		return vm.RaiseExceptionf("Channel capacity must be an integer between 0 and %d, not %v", maxCapacity, n)
+	}
+	return New(vm, int(n))
+}
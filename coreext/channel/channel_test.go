@@ -0,0 +1,90 @@
+package channel_test
+
+import (
+	"testing"
+
+	"github.com/zephyrtronium/iolang"
+	"github.com/zephyrtronium/iolang/coreext/channel"
+	_ "github.com/zephyrtronium/iolang/coreext/future" // asyncSend
+	"github.com/zephyrtronium/iolang/internal"
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+func TestRegister(t *testing.T) {
+	testutils.CheckNewSlots(t, testutils.VM().Core, []string{"Channel"})
+}
+
+func TestChannelMethods(t *testing.T) {
+	vm := testutils.VM()
+	vm.SetSlot(vm.Lobby, "testValues", vm.NewObject(nil))
+	list1to5 := vm.NewList(vm.NewNumber(1), vm.NewNumber(2), vm.NewNumber(3), vm.NewNumber(4), vm.NewNumber(5))
+	cases := map[string]map[string]testutils.SourceTestCase{
+		"send": {
+			"buffered": {Source: `Channel with(1) send(1) size`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"closed":   {Source: `Channel with(1) close send(1)`, Pass: testutils.PassFailure()},
+			"continue": {Source: `Channel with(1) send(continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+		},
+		"receive": {
+			"buffered":    {Source: `Channel with(1) send(1) receive`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"closedEmpty": {Source: `Channel with(1) close receive`, Pass: testutils.PassIdentical(vm.Nil)},
+			"drains":      {Source: `Channel with(1) send(1) close receive`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"producerConsumer": {
+				Source: `testValues ch := Channel with(2)
+					asyncSend(for(i, 1, 5, testValues ch send(i)); testValues ch close)
+					r := list()
+					while(v := testValues ch receive, r append(v))
+					r`,
+				Pass: testutils.PassEqual(list1to5),
+			},
+		},
+		"isClosed": {
+			"open":   {Source: `Channel clone isClosed`, Pass: testutils.PassIdentical(vm.False)},
+			"closed": {Source: `Channel clone close isClosed`, Pass: testutils.PassIdentical(vm.True)},
+			"twice":  {Source: `Channel clone close close isClosed`, Pass: testutils.PassIdentical(vm.True)},
+		},
+		"with": {
+			"capacity": {Source: `Channel with(3) capacity`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+			"clone":    {Source: `Channel with(3) clone capacity`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+			"negative": {Source: `Channel with(-1)`, Pass: testutils.PassFailure()},
+			"nan":      {Source: `Channel with(0 / 0)`, Pass: testutils.PassFailure()},
+			"huge":     {Source: `Channel with(1e18)`, Pass: testutils.PassFailure()},
+			"inf":      {Source: `Channel with(1 / 0)`, Pass: testutils.PassFailure()},
+			"fraction": {Source: `Channel with(1.5)`, Pass: testutils.PassFailure()},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			for name, s := range c {
+				t.Run(name, s.TestFunc("TestChannelMethods"))
+			}
+		})
+	}
+	vm.RemoveSlot(vm.Lobby, "testValues")
+}
+
+// TestChannelRemoteStop tests that coroutines blocked on a Channel respond to
+// pause, resume, and exceptions.
+func TestChannelRemoteStop(t *testing.T) {
+	vm := testutils.VM()
+	coro := vm.VMFor(vm.Coro.Clone())
+	vm.Sched.Start(coro)
+	defer vm.Sched.Finish(coro)
+	c := channel.NewChannel(0)
+	v := vm.NewNumber(1)
+	go func() {
+		coro.Control <- iolang.RemoteStop{Control: internal.PauseStop}
+		coro.Control <- iolang.RemoteStop{Control: internal.ResumeStop}
+		c.C <- v
+	}()
+	if r, stop := c.Receive(coro); stop != iolang.NoStop || r != v {
+		t.Errorf("wrong receive after resume: want %v, got %v with %v", v, r, stop)
+	}
+	exc := vm.NewExceptionf("stop")
+	go func() {
+		coro.Control <- iolang.RemoteStop{Control: internal.PauseStop}
+		coro.Control <- iolang.RemoteStop{Result: exc, Control: iolang.ExceptionStop}
+	}()
+	if r, stop := c.Receive(coro); stop != iolang.ExceptionStop || r != exc {
+		t.Errorf("wrong receive after exception: want %v, got %v with %v", exc, r, stop)
+	}
+}
@@ -3,6 +3,8 @@ package coreext
 import (
 	// importing for side effects
 	_ "github.com/zephyrtronium/iolang/coreext/addon"
+	_ "github.com/zephyrtronium/iolang/coreext/atomicnumber"
+	_ "github.com/zephyrtronium/iolang/coreext/channel"
 	_ "github.com/zephyrtronium/iolang/coreext/collector"
 	_ "github.com/zephyrtronium/iolang/coreext/coroutine"
 	_ "github.com/zephyrtronium/iolang/coreext/date"
@@ -11,6 +13,7 @@ import (
 	_ "github.com/zephyrtronium/iolang/coreext/duration"
 	_ "github.com/zephyrtronium/iolang/coreext/file"
 	_ "github.com/zephyrtronium/iolang/coreext/future"
+	_ "github.com/zephyrtronium/iolang/coreext/log"
 	_ "github.com/zephyrtronium/iolang/coreext/path"
 	_ "github.com/zephyrtronium/iolang/coreext/unittest"
 )
@@ -69,6 +69,9 @@ func TestObjectMethods(t *testing.T) {
 		"pause": {
 			"pause": {Source: `testValues pauseValue := 0; testValues pauseCoro := coroDo(testValues pauseValue = 1; Object pause; testValues pauseValue = 2); while(testValues pauseValue == 0, yield); while(Scheduler coroCount > 0, yield); testValues pauseObs := testValues pauseValue; testValues pauseCoro resume; while(testValues pauseValue < 2, yield); testValues pauseObs`, Pass: testutils.PassEqual(vm.NewNumber(1))},
 		},
+		"yield": {
+			"performLater": {Source: `testValues yieldLater := list; testValues yieldLater performLater("append", 1); testValues yieldLater append(2); yield; testValues yieldLater append(3); testValues yieldLater`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(2), vm.NewNumber(1), vm.NewNumber(3)))},
+		},
 	}
 	for name, c := range cases {
 		t.Run(name, func(t *testing.T) {
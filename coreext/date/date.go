@@ -6,6 +6,7 @@ package date
 import (
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/zephyrtronium/iolang"
@@ -50,43 +51,44 @@ func init() {
 
 func initDate(vm *iolang.VM) {
 	slots := iolang.Slots{
-		"+=":                vm.NewCFunction(plusEq, DateTag),
-		"-":                 vm.NewCFunction(minus, DateTag),
-		"-=":                vm.NewCFunction(minusEq, DateTag),
-		"asNumber":          vm.NewCFunction(asNumber, DateTag),
-		"asString":          vm.NewCFunction(asString, DateTag),
-		"clock":             vm.NewCFunction(clock, nil),
-		"convertToLocal":    vm.NewCFunction(convertToLocal, DateTag),
-		"convertToLocation": vm.NewCFunction(convertToLocation, DateTag),
-		"convertToUTC":      vm.NewCFunction(convertToUTC, DateTag),
-		"copy":              vm.NewCFunction(dateCopy, DateTag),
-		"cpuSecondsToRun":   vm.NewCFunction(cpuSecondsToRun, nil),
-		"day":               vm.NewCFunction(day, DateTag),
-		"fromNumber":        vm.NewCFunction(fromNumber, DateTag),
-		"fromString":        vm.NewCFunction(fromString, DateTag),
-		"gmtOffset":         vm.NewCFunction(gmtOffset, DateTag),
-		"gmtOffsetSeconds":  vm.NewCFunction(gmtOffsetSeconds, DateTag),
-		"hour":              vm.NewCFunction(hour, DateTag),
-		"isDST":             vm.NewCFunction(isDST, DateTag),
-		"isPast":            vm.NewCFunction(isPast, DateTag),
-		"isValidTime":       vm.NewCFunction(isValidTime, nil),
-		"location":          vm.NewCFunction(location, nil),
-		"minute":            vm.NewCFunction(minute, DateTag),
-		"month":             vm.NewCFunction(month, DateTag),
-		"now":               vm.NewCFunction(now, DateTag),
-		"second":            vm.NewCFunction(second, DateTag),
-		"secondsSince":      vm.NewCFunction(secondsSince, DateTag),
-		"secondsSinceNow":   vm.NewCFunction(secondsSinceNow, DateTag),
-		"setDay":            vm.NewCFunction(setDay, DateTag),
-		"setGmtOffset":      vm.NewCFunction(setGmtOffset, DateTag),
-		"setHour":           vm.NewCFunction(setHour, DateTag),
-		"setMinute":         vm.NewCFunction(setMinute, DateTag),
-		"setMonth":          vm.NewCFunction(setMonth, DateTag),
-		"setSecond":         vm.NewCFunction(setSecond, DateTag),
-		"setToUTC":          vm.NewCFunction(setToUTC, DateTag),
-		"setYear":           vm.NewCFunction(setYear, DateTag),
-		"type":              vm.NewString("Date"),
-		"year":              vm.NewCFunction(year, DateTag),
+		"+=":                 vm.NewCFunction(plusEq, DateTag),
+		"-":                  vm.NewCFunction(minus, DateTag),
+		"-=":                 vm.NewCFunction(minusEq, DateTag),
+		"asNumber":           vm.NewCFunction(asNumber, DateTag),
+		"asString":           vm.NewCFunction(asString, DateTag),
+		"asStringWithFormat": vm.NewCFunction(asStringWithFormat, DateTag),
+		"clock":              vm.NewCFunction(clock, nil),
+		"convertToLocal":     vm.NewCFunction(convertToLocal, DateTag),
+		"convertToLocation":  vm.NewCFunction(convertToLocation, DateTag),
+		"convertToUTC":       vm.NewCFunction(convertToUTC, DateTag),
+		"copy":               vm.NewCFunction(dateCopy, DateTag),
+		"cpuSecondsToRun":    vm.NewCFunction(cpuSecondsToRun, nil),
+		"day":                vm.NewCFunction(day, DateTag),
+		"fromNumber":         vm.NewCFunction(fromNumber, DateTag),
+		"fromString":         vm.NewCFunction(fromString, DateTag),
+		"gmtOffset":          vm.NewCFunction(gmtOffset, DateTag),
+		"gmtOffsetSeconds":   vm.NewCFunction(gmtOffsetSeconds, DateTag),
+		"hour":               vm.NewCFunction(hour, DateTag),
+		"isDST":              vm.NewCFunction(isDST, DateTag),
+		"isPast":             vm.NewCFunction(isPast, DateTag),
+		"isValidTime":        vm.NewCFunction(isValidTime, nil),
+		"location":           vm.NewCFunction(location, nil),
+		"minute":             vm.NewCFunction(minute, DateTag),
+		"month":              vm.NewCFunction(month, DateTag),
+		"now":                vm.NewCFunction(now, DateTag),
+		"second":             vm.NewCFunction(second, DateTag),
+		"secondsSince":       vm.NewCFunction(secondsSince, DateTag),
+		"secondsSinceNow":    vm.NewCFunction(secondsSinceNow, DateTag),
+		"setDay":             vm.NewCFunction(setDay, DateTag),
+		"setGmtOffset":       vm.NewCFunction(setGmtOffset, DateTag),
+		"setHour":            vm.NewCFunction(setHour, DateTag),
+		"setMinute":          vm.NewCFunction(setMinute, DateTag),
+		"setMonth":           vm.NewCFunction(setMonth, DateTag),
+		"setSecond":          vm.NewCFunction(setSecond, DateTag),
+		"setToUTC":           vm.NewCFunction(setToUTC, DateTag),
+		"setYear":            vm.NewCFunction(setYear, DateTag),
+		"type":               vm.NewString("Date"),
+		"year":               vm.NewCFunction(year, DateTag),
 	}
 	// isDST and isDaylightSavingsTime are distinct in Io, but they seem to
 	// serve the same purpose, with the former inspecting the struct timezone
@@ -129,6 +131,24 @@ func asString(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message)
 	return vm.NewString(lctime.Strftime(format, d))
 }
 
+// asStringWithFormat is a Date method.
+//
+// asStringWithFormat formats the date according to the given layout, which is
+// either an ANSI C datetime format, if it contains any % directives, or a Go
+// reference time layout such as "2006-01-02 15:04:05 MST" otherwise. The date
+// is formatted in its own location, which is the local timezone unless it has
+// been converted; see fromString.
+func asStringWithFormat(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	format, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != iolang.NoStop {
+		return vm.Stop(exc, stop)
+	}
+	target.Lock()
+	d := target.Value.(time.Time)
+	target.Unlock()
+	return vm.NewString(d.Format(goLayout(format)))
+}
+
 // clock is a Date method.
 //
 // clock returns the number of seconds since Io initialization as a Number.
@@ -237,7 +257,11 @@ func fromNumber(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Messag
 
 // fromString is a Date method.
 //
-// fromString creates a date from the given string representation.
+// fromString creates a date from the given string representation, using a
+// layout in the same forms accepted by asStringWithFormat. If the layout
+// includes no timezone, the date is taken to be in the local timezone, so that
+// formatting a date and parsing the result with the same layout produces the
+// same time.
 func fromString(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
 	str, err, stop := msg.StringArgAt(vm, locals, 0)
 	if stop != iolang.NoStop {
@@ -249,12 +273,10 @@ func fromString(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Messag
 		return vm.Stop(err, stop)
 	}
 
-	longDate := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.FixedZone("MST", -7*60*60))
-	longForm := lctime.Strftime(format, longDate)
-
-	v, r := time.Parse(longForm, str)
+	longForm := goLayout(format)
+	v, r := time.ParseInLocation(longForm, str, time.Local)
 	if r != nil {
-		return vm.RaiseExceptionf("argument 0 to - must be a valid date string (%s)", longForm)
+		return vm.RaiseExceptionf("argument 0 to fromString must be a valid date string (%s)", longForm)
 	}
 
 	target.Lock()
@@ -264,6 +286,17 @@ func fromString(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Messag
 	return target
 }
 
+// goLayout converts a date format to a Go reference time layout. If format
+// contains a % directive, it is interpreted as an ANSI C datetime format;
+// otherwise, it is already a Go layout.
+func goLayout(format string) string {
+	if !strings.Contains(format, "%") {
+		return format
+	}
+	longDate := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.FixedZone("MST", -7*60*60))
+	return lctime.Strftime(format, longDate)
+}
+
 // gmtOffset is a Date method.
 //
 // gmtOffset returns the date's timezone offset to UTC as a string.
@@ -445,6 +478,7 @@ func plusEq(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *
 	}
 	target.Lock()
 	target.Value = target.Value.(time.Time).Add(dur)
+	target.Unlock()
 	return target
 }
 
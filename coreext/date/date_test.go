@@ -11,3 +11,31 @@ func TestRegister(t *testing.T) {
 	// Duration is a dependency.
 	testutils.CheckNewSlots(t, testutils.VM().Core, []string{"Date", "Duration"})
 }
+
+func TestDateArithmetic(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"plus":    {Source: `(Date clone fromNumber(0) + Duration clone fromNumber(60)) asNumber`, Pass: testutils.PassEqual(vm.NewNumber(60))},
+		"plusEq":  {Source: `Object clone do(d := Date clone fromNumber(0); d += Duration clone fromNumber(60); r := d asNumber) r`, Pass: testutils.PassEqual(vm.NewNumber(60))},
+		"minus":   {Source: `(Date clone fromNumber(90) - Date clone fromNumber(30)) asSeconds`, Pass: testutils.PassEqual(vm.NewNumber(60))},
+		"badPlus": {Source: `Date clone fromNumber(0) + 1`, Pass: testutils.PassFailure()},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestDateArithmetic"))
+	}
+}
+
+func TestDateFormat(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"goLayout":  {Source: `Date clone fromNumber(0) convertToUTC asStringWithFormat("2006-01-02 15:04:05")`, Pass: testutils.PassEqual(vm.NewString("1970-01-01 00:00:00"))},
+		"strftime":  {Source: `Date clone fromNumber(0) convertToUTC asStringWithFormat("%Y/%m/%d %H:%M")`, Pass: testutils.PassEqual(vm.NewString("1970/01/01 00:00"))},
+		"parseGo":   {Source: `Date clone fromString("1970-01-01 00:01:00 +0000", "2006-01-02 15:04:05 -0700") asNumber`, Pass: testutils.PassEqual(vm.NewNumber(60))},
+		"parseC":    {Source: `Date clone fromString("1970-01-02 03:04", "%Y-%m-%d %H:%M") asNumber == Date clone fromString("1970-01-02 03:04", "2006-01-02 15:04") asNumber`, Pass: testutils.PassIdentical(vm.True)},
+		"roundTrip": {Source: `Object clone do(d := Date clone fromNumber(86400 * 365); s := d asStringWithFormat("2006-01-02 15:04:05"); r := Date clone fromString(s, "2006-01-02 15:04:05") asNumber) r`, Pass: testutils.PassEqual(vm.NewNumber(86400 * 365))},
+		"badParse":  {Source: `Date clone fromString("yesterday", "2006-01-02")`, Pass: testutils.PassFailure()},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestDateFormat"))
+	}
+}
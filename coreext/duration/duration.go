@@ -1,6 +1,3 @@
-//go:generate go run ../../cmd/gencore duration_init.go duration ./io
-//go:generate gofmt -s -w duration_init.go
-
 package duration
 
 import (
@@ -48,12 +45,16 @@ func init() {
 
 func initDuration(vm *iolang.VM) {
 	slots := iolang.Slots{
+		"*":          vm.NewCFunction(times, DurationTag),
+		"+":          vm.NewCFunction(plus, DurationTag),
 		"+=":         vm.NewCFunction(plusEq, DurationTag),
+		"-":          vm.NewCFunction(minus, DurationTag),
 		"-=":         vm.NewCFunction(minusEq, DurationTag),
 		"asNumber":   vm.NewCFunction(asNumber, DurationTag),
 		"asString":   vm.NewCFunction(asString, DurationTag),
 		"days":       vm.NewCFunction(days, DurationTag),
 		"fromNumber": vm.NewCFunction(fromNumber, DurationTag),
+		"fromString": vm.NewCFunction(fromString, DurationTag),
 		"hours":      vm.NewCFunction(hours, DurationTag),
 		"minutes":    vm.NewCFunction(minutes, DurationTag),
 		"seconds":    vm.NewCFunction(seconds, DurationTag),
@@ -65,9 +66,9 @@ func initDuration(vm *iolang.VM) {
 		"type":       vm.NewString("Duration"),
 		"years":      vm.NewCFunction(years, DurationTag),
 	}
+	slots["asSeconds"] = slots["asNumber"]
 	slots["totalSeconds"] = slots["asNumber"]
 	internal.CoreInstall(vm, "Duration", slots, time.Duration(0), DurationTag)
-	internal.Ioz(vm, coreIo, coreFiles)
 }
 
 // asNumber is a Duration method.
@@ -147,6 +148,26 @@ func fromNumber(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Messag
 	return target
 }
 
+// fromString is a Duration method.
+//
+// fromString sets the duration to that represented by the given string, which
+// is a sequence of decimal numbers with units, like "1h30m" or "-1.5s". Valid
+// units are "ns", "us", "ms", "s", "m", and "h".
+func fromString(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	s, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != iolang.NoStop {
+		return vm.Stop(exc, stop)
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return vm.IoError(err)
+	}
+	target.Lock()
+	target.Value = d
+	target.Unlock()
+	return target
+}
+
 // hours is a Duration method.
 //
 // hours returns the number of whole hours the duration represents, modulo 24.
@@ -157,6 +178,21 @@ func hours(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *i
 	return vm.NewNumber(float64(int64(d.Hours()) % 24))
 }
 
+// minus is a Duration method.
+//
+// - returns a clone of this duration holding this duration less the argument
+// duration.
+func minus(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	dd, exc, stop := ArgAt(vm, msg, locals, 0)
+	if stop != iolang.NoStop {
+		return vm.Stop(exc, stop)
+	}
+	target.Lock()
+	d := target.Value.(time.Duration)
+	target.Unlock()
+	return vm.ObjectWith(nil, []*iolang.Object{target}, d-dd, DurationTag)
+}
+
 // minusEq is a Duration method.
 //
 // -= decreases this duration by the argument duration.
@@ -183,6 +219,21 @@ func minutes(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message)
 	return vm.NewNumber(float64(int64(d.Minutes()) % 60))
 }
 
+// plus is a Duration method.
+//
+// + returns a clone of this duration holding the sum of this duration and the
+// argument duration.
+func plus(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	dd, exc, stop := ArgAt(vm, msg, locals, 0)
+	if stop != iolang.NoStop {
+		return vm.Stop(exc, stop)
+	}
+	target.Lock()
+	d := target.Value.(time.Duration)
+	target.Unlock()
+	return vm.ObjectWith(nil, []*iolang.Object{target}, d+dd, DurationTag)
+}
+
 // plusEq is a Duration method.
 //
 // += increases this duration by the argument duration.
@@ -301,6 +352,21 @@ func setYears(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message)
 	return target
 }
 
+// times is a Duration method.
+//
+// * returns a clone of this duration holding this duration scaled by the
+// argument number, rounded to the nearest nanosecond.
+func times(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	n, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != iolang.NoStop {
+		return vm.Stop(exc, stop)
+	}
+	target.Lock()
+	d := target.Value.(time.Duration)
+	target.Unlock()
+	return vm.ObjectWith(nil, []*iolang.Object{target}, time.Duration(math.Round(float64(d)*n)), DurationTag)
+}
+
 // years is a Duration method.
 //
 // years returns the number of whole years represented by the duration, with a
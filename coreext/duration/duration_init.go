@@ -1,9 +0,0 @@
-package duration
-
-// Code generated by gencore; DO NOT EDIT
-
-var coreIo = []string{
-	"x\x9cr)-J,\xc9\xcc\xcfSH\xc9\xd7\xe0\xe2,N-\t\xce\xc9/\xd1P\xd2V\xd2Q\xc8M-\xc9\xc8O\xd1\xc8/\xc9H-\xd2Q(N\xcdISH\xce\xc9\xcfKUжU\x00\vjj\"\xe9\xd0ŧC\x17\xa1C\x93\v\x10\x00\x00\xff\xff}7$\x87",
-}
-
-var coreFiles = []string{"io/Duration.io"}
@@ -10,3 +10,24 @@ import (
 func TestRegister(t *testing.T) {
 	testutils.CheckNewSlots(t, testutils.VM().Core, []string{"Duration"})
 }
+
+func TestDurationMethods(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"plus":       {Source: `(Duration clone fromNumber(60) + Duration clone fromNumber(30)) asSeconds`, Pass: testutils.PassEqual(vm.NewNumber(90))},
+		"plusCopy":   {Source: `Object clone do(d := Duration clone fromNumber(60); d + d; r := d asSeconds) r`, Pass: testutils.PassEqual(vm.NewNumber(60))},
+		"minus":      {Source: `(Duration clone fromNumber(60) - Duration clone fromNumber(90)) asSeconds`, Pass: testutils.PassEqual(vm.NewNumber(-30))},
+		"times":      {Source: `(Duration clone fromNumber(60) * 1.5) asSeconds`, Pass: testutils.PassEqual(vm.NewNumber(90))},
+		"plusKind":   {Source: `Object clone do(D := Duration clone; r := (D + D) isKindOf(D)) r`, Pass: testutils.PassIdentical(vm.True)},
+		"minusKind":  {Source: `Object clone do(D := Duration clone; r := (D - D) isKindOf(D)) r`, Pass: testutils.PassIdentical(vm.True)},
+		"timesKind":  {Source: `Object clone do(D := Duration clone; r := (D * 2) isKindOf(D)) r`, Pass: testutils.PassIdentical(vm.True)},
+		"fromString": {Source: `Duration clone fromString("1h30m") asSeconds`, Pass: testutils.PassEqual(vm.NewNumber(5400))},
+		"asString":   {Source: `Duration clone fromString("1h30m15s") asString("%H:%M")`, Pass: testutils.PassEqual(vm.NewString("01:30"))},
+		"badString":  {Source: `Duration clone fromString("soon")`, Pass: testutils.PassFailure()},
+		"badPlus":    {Source: `Duration clone + 1`, Pass: testutils.PassFailure()},
+		"badTimes":   {Source: `Duration clone * Duration clone`, Pass: testutils.PassFailure()},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestDurationMethods"))
+	}
+}
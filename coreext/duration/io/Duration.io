@@ -1,4 +0,0 @@
-Duration do(
-	setSlot("+", method(other, self clone += other))
-	setSlot("-", method(other, self clone -= other))
-)
@@ -334,6 +334,11 @@ func flush(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *i
 	target.Lock()
 	f := target.Value.(File)
 	target.Unlock()
+	if f.File == os.Stdout {
+		if err := vm.Stdout.Flush(); err != nil {
+			return vm.IoError(err)
+		}
+	}
 	if err := f.File.Sync(); err != nil {
 		return vm.IoError(err)
 	}
@@ -1103,7 +1108,13 @@ func write(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *i
 		obj.Lock()
 		v := s.Bytes()
 		obj.Unlock()
-		_, err := f.File.Write(v)
+		var err error
+		if f.File == os.Stdout {
+			// Go through the VM's output so that we respect buffering.
+			_, err = vm.Stdout.Write(v)
+		} else {
+			_, err = f.File.Write(v)
+		}
 		if err != nil {
 			return vm.IoError(err)
 		}
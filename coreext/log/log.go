@@ -0,0 +1,240 @@
+package log
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zephyrtronium/iolang"
+	"github.com/zephyrtronium/iolang/internal"
+)
+
+// A Level is the severity of a log message.
+type Level int
+
+// Log levels, in increasing order of severity.
+const (
+	Debug Level = iota
+	Info
+	Warn
+	Error
+)
+
+var levelNames = [...]string{"debug", "info", "warn", "error"}
+
+// String returns the lowercase name of the level.
+func (l Level) String() string {
+	if l < Debug || l > Error {
+		return fmt.Sprintf("Level(%d)", int(l))
+	}
+	return levelNames[l]
+}
+
+// ParseLevel returns the level with the given name, ignoring case.
+func ParseLevel(name string) (Level, error) {
+	for i, n := range levelNames {
+		if strings.EqualFold(name, n) {
+			return Level(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown log level %q", name)
+}
+
+// A Logger holds the configuration of a Logger object.
+type Logger struct {
+	mu sync.Mutex
+	// Level is the minimum level of messages the logger writes.
+	Level Level
+	// Output is the object to which the logger sends write messages. If it is
+	// nil, lines are written to the VM's standard error.
+	Output *iolang.Object
+}
+
+// Config returns the logger's minimum level and output object.
+func (l *Logger) Config() (Level, *iolang.Object) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.Level, l.Output
+}
+
+// tagLogger is the Tag type for Logger objects.
+type tagLogger struct{}
+
+func (tagLogger) Activate(vm *iolang.VM, self, target, locals, context *iolang.Object, msg *iolang.Message) *iolang.Object {
+	return self
+}
+
+func (tagLogger) CloneValue(value interface{}) interface{} {
+	level, out := value.(*Logger).Config()
+	return &Logger{Level: level, Output: out}
+}
+
+func (tagLogger) String() string {
+	return "Logger"
+}
+
+// LoggerTag is the Tag for Logger objects. Activate returns self. CloneValue
+// creates a new Logger with the same level and output as the parent.
+var LoggerTag tagLogger
+
+// New creates a new Logger object with the given minimum level, writing to the
+// VM's standard error.
+func New(vm *iolang.VM, level Level) *iolang.Object {
+	return vm.ObjectWith(nil, vm.CoreProto("Logger"), &Logger{Level: level}, LoggerTag)
+}
+
+func init() {
+	internal.Register(initLogger)
+}
+
+func initLogger(vm *iolang.VM) {
+	slots := iolang.Slots{
+		"debug":      vm.NewCFunction(logAt(Debug), LoggerTag),
+		"error":      vm.NewCFunction(logAt(Error), LoggerTag),
+		"formatLine": vm.NewCFunction(formatLine, nil),
+		"info":       vm.NewCFunction(logAt(Info), LoggerTag),
+		"isEnabled":  vm.NewCFunction(isEnabled, LoggerTag),
+		"level":      vm.NewCFunction(level, LoggerTag),
+		"output":     vm.NewCFunction(output, LoggerTag),
+		"setLevel":   vm.NewCFunction(setLevel, LoggerTag),
+		"setOutput":  vm.NewCFunction(setOutput, LoggerTag),
+		"type":       vm.NewString("Logger"),
+		"warn":       vm.NewCFunction(logAt(Warn), LoggerTag),
+	}
+	internal.CoreInstall(vm, "Logger", slots, &Logger{Level: Info}, LoggerTag)
+}
+
+// logAt returns a Logger method which logs at the given level.
+//
+// debug, info, warn, and error each write a line containing their arguments,
+// converted to strings and concatenated, if the logger's level is at most
+// that of the method. The line is produced by sending the receiver
+// formatLine with the level name and message, then sent to the logger's
+// output followed by a newline.
+func logAt(at Level) iolang.Fn {
+	return func(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+		min, out := target.Value.(*Logger).Config()
+		if at < min {
+			return target
+		}
+		var b strings.Builder
+		for i := range msg.Args {
+			r, stop := msg.EvalArgAt(vm, locals, i)
+			if stop != iolang.NoStop {
+				return vm.Stop(r, stop)
+			}
+			b.WriteString(vm.AsString(r))
+		}
+		fm := vm.IdentMessage("formatLine", vm.CachedMessage(vm.NewString(at.String())), vm.CachedMessage(vm.NewString(b.String())))
+		line, stop := vm.Perform(target, locals, fm)
+		if stop != iolang.NoStop {
+			return vm.Stop(line, stop)
+		}
+		s := vm.AsString(line) + "\n"
+		if out == nil {
+			if _, err := vm.Stderr.Write([]byte(s)); err != nil {
+				return vm.IoError(err)
+			}
+			return target
+		}
+		r, stop := vm.Perform(out, locals, vm.IdentMessage("write", vm.CachedMessage(vm.NewString(s))))
+		if stop != iolang.NoStop {
+			return vm.Stop(r, stop)
+		}
+		return target
+	}
+}
+
+// formatLine is a Logger method.
+//
+// formatLine returns the line to log for a level name and message. The default
+// format is an RFC 3339 timestamp, the uppercase level name, and the message,
+// separated by spaces. Override this slot to change the format of log lines.
+func formatLine(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	lvl, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != iolang.NoStop {
+		return vm.Stop(exc, stop)
+	}
+	s, exc, stop := msg.StringArgAt(vm, locals, 1)
+	if stop != iolang.NoStop {
+		return vm.Stop(exc, stop)
+	}
+	return vm.NewString(time.Now().Format(time.RFC3339) + " " + strings.ToUpper(lvl) + " " + s)
+}
+
+// isEnabled is a Logger method.
+//
+// isEnabled returns whether the logger writes messages at the named level.
+func isEnabled(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	s, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != iolang.NoStop {
+		return vm.Stop(exc, stop)
+	}
+	at, err := ParseLevel(s)
+	if err != nil {
+		return vm.IoError(err)
+	}
+	min, _ := target.Value.(*Logger).Config()
+	return vm.IoBool(at >= min)
+}
+
+// level is a Logger method.
+//
+// level returns the name of the minimum level of messages the logger writes.
+func level(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	min, _ := target.Value.(*Logger).Config()
+	return vm.NewString(min.String())
+}
+
+// output is a Logger method.
+//
+// output returns the object to which the logger writes, or nil if it writes to
+// standard error.
+func output(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	_, out := target.Value.(*Logger).Config()
+	if out == nil {
+		return vm.Nil
+	}
+	return out
+}
+
+// setLevel is a Logger method.
+//
+// setLevel sets the minimum level of messages the logger writes to one of
+// "debug", "info", "warn", or "error".
+func setLevel(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	s, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != iolang.NoStop {
+		return vm.Stop(exc, stop)
+	}
+	at, err := ParseLevel(s)
+	if err != nil {
+		return vm.IoError(err)
+	}
+	l := target.Value.(*Logger)
+	l.mu.Lock()
+	l.Level = at
+	l.mu.Unlock()
+	return target
+}
+
+// setOutput is a Logger method.
+//
+// setOutput sets the object to which the logger sends write messages, such as
+// File standardOutput. If the argument is nil, the logger writes to standard
+// error.
+func setOutput(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+	out, stop := msg.EvalArgAt(vm, locals, 0)
+	if stop != iolang.NoStop {
+		return vm.Stop(out, stop)
+	}
+	if out == vm.Nil {
+		out = nil
+	}
+	l := target.Value.(*Logger)
+	l.mu.Lock()
+	l.Output = out
+	l.mu.Unlock()
+	return target
+}
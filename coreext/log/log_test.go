@@ -0,0 +1,69 @@
+package log_test
+
+import (
+	"testing"
+
+	"github.com/zephyrtronium/iolang"
+	_ "github.com/zephyrtronium/iolang/coreext/log" // side effects
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+func TestRegister(t *testing.T) {
+	testutils.CheckNewSlots(t, testutils.VM().Core, []string{"Logger"})
+}
+
+func TestLoggerMethods(t *testing.T) {
+	vm := testutils.VM()
+	vm.SetSlot(vm.Lobby, "testValues", vm.NewObject(nil))
+	cases := map[string]map[string]testutils.SourceTestCase{
+		"level": {
+			"default": {Source: `Logger clone level`, Pass: testutils.PassEqual(vm.NewString("info"))},
+			"set":     {Source: `Logger clone setLevel("WARN") level`, Pass: testutils.PassEqual(vm.NewString("warn"))},
+			"clone":   {Source: `Logger clone setLevel("error") clone level`, Pass: testutils.PassEqual(vm.NewString("error"))},
+			"bad":     {Source: `Logger clone setLevel("loud")`, Pass: testutils.PassFailure()},
+		},
+		"isEnabled": {
+			"above": {Source: `Logger clone isEnabled("error")`, Pass: testutils.PassIdentical(vm.True)},
+			"below": {Source: `Logger clone isEnabled("debug")`, Pass: testutils.PassIdentical(vm.False)},
+			"bad":   {Source: `Logger clone isEnabled("loud")`, Pass: testutils.PassFailure()},
+		},
+		"log": {
+			"write": {
+				Source: `testValues lines := list
+					testValues out := Object clone do(write := method(s, testValues lines append(s)))
+					Logger clone setOutput(testValues out) do(formatLine := method(l, m, l .. ":" .. m)) info("a", 1) warn("b")
+					testValues lines`,
+				Pass: testutils.PassEqual(vm.NewList(vm.NewString("info:a1\n"), vm.NewString("warn:b\n"))),
+			},
+			"suppressed": {
+				Source: `testValues lines := list
+					testValues out := Object clone do(write := method(s, testValues lines append(s)))
+					Logger clone setOutput(testValues out) setLevel("warn") debug("a") info("b") error("c")
+					testValues lines size`,
+				Pass: testutils.PassEqual(vm.NewNumber(1)),
+			},
+			"format": {
+				Source: `testValues lines := list
+					testValues out := Object clone do(write := method(s, testValues lines append(s)))
+					Logger clone setOutput(testValues out) error("c")
+					testValues lines first endsWithSeq(" ERROR c\n")`,
+				Pass: testutils.PassIdentical(vm.True),
+			},
+			"lazy":      {Source: `Logger clone debug(Exception raise)`, Pass: testutils.PassSuccess()},
+			"continue":  {Source: `Logger clone info(continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+			"exception": {Source: `Logger clone info(Exception raise)`, Pass: testutils.PassFailure()},
+		},
+		"output": {
+			"default": {Source: `Logger clone output`, Pass: testutils.PassIdentical(vm.Nil)},
+			"reset":   {Source: `Logger clone setOutput(Object) setOutput(nil) output`, Pass: testutils.PassIdentical(vm.Nil)},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			for name, s := range c {
+				t.Run(name, s.TestFunc("TestLoggerMethods"))
+			}
+		})
+	}
+	vm.RemoveSlot(vm.Lobby, "testValues")
+}
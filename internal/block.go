@@ -2,6 +2,7 @@ package internal
 
 import (
 	"bytes"
+	"sort"
 	"strings"
 	"sync/atomic"
 )
@@ -112,6 +113,19 @@ func (c *Call) SetStatus(s Stop) {
 	atomic.StoreInt32(&c.status, int32(s))
 }
 
+// callFrame records a block activation in progress for callStack.
+type callFrame struct {
+	msg    *Message
+	target *Object
+	locals *Object
+}
+
+// MaxCallStack is the maximum number of frames that callStack reports. The VM
+// places no limit on recursion depth, since goroutine stacks grow as needed,
+// so there is no recursion limit to derive this from; it only bounds the size
+// of callStack's result.
+const MaxCallStack = 1000
+
 // ActivateBlock activates a block directly, regardless of the value of its
 // Activatable flag. Panics if blk is not a Block object.
 func (vm *VM) ActivateBlock(blk, target, locals, context *Object, msg *Message) *Object {
@@ -129,7 +143,11 @@ func (vm *VM) ActivateBlock(blk, target, locals, context *Object, msg *Message)
 		}
 		vm.SetSlot(blkLocals, arg, x)
 	}
+	vm.frames = append(vm.frames, callFrame{msg: msg, target: target, locals: blkLocals})
 	result, stop := b.Message.Eval(vm, blkLocals)
+	n := len(vm.frames) - 1
+	vm.frames[n] = callFrame{}
+	vm.frames = vm.frames[:n]
 	if b.PassStops || stop == ExceptionStop || stop == ExitStop {
 		return vm.Stop(result, stop)
 	}
@@ -152,6 +170,38 @@ func (vm *VM) NewBlock(msg *Message, scope *Object, args ...string) *Object {
 	return vm.ObjectWith(nil, vm.CoreProto("Block"), value, BlockTag)
 }
 
+// NamedArgs returns the values in named ordered by the block's argument names,
+// suitable for activating the block positionally. If any argument name is not
+// a key in named, then args is nil and missing is the first such name. extra
+// holds the keys of named which are not argument names, in sorted order.
+func (b *Block) NamedArgs(named map[string]*Object) (args []*Object, missing string, extra []string) {
+	args = make([]*Object, len(b.ArgNames))
+	for i, name := range b.ArgNames {
+		v, ok := named[name]
+		if !ok {
+			return nil, name, nil
+		}
+		args[i] = v
+	}
+	for k := range named {
+		if !b.hasArg(k) {
+			extra = append(extra, k)
+		}
+	}
+	sort.Strings(extra)
+	return args, "", extra
+}
+
+// hasArg returns whether name is one of the block's argument names.
+func (b *Block) hasArg(name string) bool {
+	for _, n := range b.ArgNames {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
 // NewLocals instantiates a Locals object for a block activation.
 func (vm *VM) NewLocals(self, call *Object) *Object {
 	slots := Slots{
@@ -190,6 +240,7 @@ func (vm *VM) initBlock() {
 		"setScope":         vm.NewCFunction(BlockSetScope, BlockTag),
 		"type":             vm.NewString("Block"),
 	}
+	slots["bodyMessage"] = slots["message"]
 	slots["code"] = slots["asString"]
 	vm.coreInstall("Block", slots, &Block{}, BlockTag)
 }
@@ -248,6 +299,35 @@ func ObjectBlock(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.NewBlock(msg.ArgAt(n-1), locals, args...)
 }
 
+// ObjectCallStack is an Object method.
+//
+// callStack returns a list of the block and method activations in progress in
+// the current coroutine, innermost first. Each is an object with slots
+// message, a copy of the message which activated the block, target, its
+// receiver, and locals, a snapshot of the activation's locals taken when
+// callStack is called. Activations from Go with no message have nil as their
+// message. Only the innermost MaxCallStack activations are included.
+func ObjectCallStack(vm *VM, target, locals *Object, msg *Message) *Object {
+	n := len(vm.frames)
+	if n > MaxCallStack {
+		n = MaxCallStack
+	}
+	l := make([]*Object, n)
+	for i := range l {
+		f := vm.frames[len(vm.frames)-1-i]
+		m := vm.Nil
+		if f.msg != nil {
+			m = vm.MessageObject(f.msg.DeepCopy())
+		}
+		l[i] = vm.NewObject(Slots{
+			"message": m,
+			"target":  f.target,
+			"locals":  vm.ObjectWith(vm.GetAllSlots(f.locals), f.locals.Protos(), nil, nil),
+		})
+	}
+	return vm.NewList(l...)
+}
+
 // ObjectMethod is an Object method, which is less redundant than it sounds.
 //
 // method creates a block of messages referring to the method antecedent.
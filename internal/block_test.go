@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/zephyrtronium/iolang"
+	"github.com/zephyrtronium/iolang/internal"
 	"github.com/zephyrtronium/iolang/testutils"
 )
 
@@ -19,3 +20,69 @@ func BenchmarkMethodActivate(b *testing.B) {
 		m.Activate(vm, vm.Lobby, vm.Lobby, vm.Lobby, msg)
 	}
 }
+
+// TestBlockMethods tests Block methods by executing Io scripts.
+func TestBlockMethods(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]map[string]testutils.SourceTestCase{
+		"argumentNames": {
+			"method": {Source: `method(a, b, a + b) argumentNames`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a"), vm.NewString("b")))},
+			"block":  {Source: `block(x, x) argumentNames`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("x")))},
+			"none":   {Source: `method(nil) argumentNames`, Pass: testutils.PassEqual(vm.NewList())},
+			"set":    {Source: `method(nil) setArgumentNames("y") argumentNames`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("y")))},
+		},
+		"bodyMessage": {
+			"name":  {Source: `method(a, b, a + b) bodyMessage name`, Pass: testutils.PassEqual(vm.NewString("a"))},
+			"next":  {Source: `method(a, b, a + b) bodyMessage next name`, Pass: testutils.PassEqual(vm.NewString("+"))},
+			"set":   {Source: `method(a, a) setMessage(message(b)) bodyMessage name`, Pass: testutils.PassEqual(vm.NewString("b"))},
+			"empty": {Source: `method() bodyMessage type`, Pass: testutils.PassEqual(vm.NewString("Message"))},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			for name, s := range c {
+				t.Run(name, s.TestFunc("TestBlockMethods"))
+			}
+		})
+	}
+}
+
+// TestObjectCallStack tests that callStack reports block activations.
+func TestObjectCallStack(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"top":            {Source: `callStack size`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+		"nested":         {Source: `Object clone do(f := method(g); g := method(callStack); r := f map(message name)) r`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("g"), vm.NewString("f")))},
+		"block":          {Source: `block(callStack size) call`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+		"locals":         {Source: `Object clone do(f := method(x, callStack first locals x); r := f(7)) r`, Pass: testutils.PassEqual(vm.NewNumber(7))},
+		"localsSnapshot": {Source: `Object clone do(f := method(x, s := callStack first locals; x = 8; s x); r := f(7)) r`, Pass: testutils.PassEqual(vm.NewNumber(7))},
+		"target":         {Source: `Object clone do(f := method(callStack first target); o := thisContext; r := f isIdenticalTo(o)) r`, Pass: testutils.PassIdentical(vm.True)},
+		"snapshot":       {Source: `Object clone do(f := method(callStack first message); m := f; m setName("g"); r := f name) r`, Pass: testutils.PassEqual(vm.NewString("f"))},
+		"popped":         {Source: `Object clone do(f := method(nil); f; r := callStack size) r`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+		"bounded":        {Source: `Object clone do(f := method(n, if(n == 0, callStack size, f(n - 1))); r := f(1500)) r`, Pass: testutils.PassEqual(vm.NewNumber(internal.MaxCallStack))},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestObjectCallStack/"+name))
+	}
+}
+
+// TestObjectCallStackNilMessage tests that callStack reports nil as the
+// message of blocks activated from Go without a message.
+func TestObjectCallStackNilMessage(t *testing.T) {
+	vm := testutils.VM()
+	blk, stop := vm.DoString(`block(callStack first)`, "TestObjectCallStackNilMessage")
+	if stop != iolang.NoStop {
+		t.Fatalf("couldn't create block: %s", vm.AsString(blk))
+	}
+	r := vm.ActivateBlock(blk, vm.Lobby, vm.Lobby, vm.Lobby, nil)
+	if r, stop := vm.Status(r); stop != iolang.NoStop {
+		t.Fatalf("callStack raised %s", vm.AsString(r))
+	}
+	m, ok := vm.GetLocalSlot(r, "message")
+	if !ok {
+		t.Fatal("frame has no message slot")
+	}
+	if m != vm.Nil {
+		t.Errorf("wrong message: want nil, got %v", m)
+	}
+}
@@ -62,6 +62,14 @@ func (vm *VM) NewCFunction(f Fn, kind Tag) *Object {
 	return vm.ObjectWith(nil, vm.CoreProto("CFunction"), value, CFunctionTag)
 }
 
+// AddMethod creates a CFunction wrapping f and sets it as the slot name on
+// proto, e.g. vm.AddMethod(vm.CoreObject("Sequence"), "rot13", rot13,
+// iolang.SequenceTag). As with NewCFunction, a non-nil tag restricts the
+// method to receivers with that tag.
+func (vm *VM) AddMethod(proto *Object, name string, f Fn, tag Tag) {
+	vm.SetSlot(proto, name, vm.NewCFunction(f, tag))
+}
+
 // String returns the name of the object.
 func (f CFunction) String() string {
 	return f.Name
@@ -1,6 +1,9 @@
 package internal
 
-import "fmt"
+import (
+	"fmt"
+	"runtime"
+)
 
 // Stop represents the reason for flow control.
 type Stop int
@@ -125,6 +128,33 @@ func (vm *VM) Status(result *Object) (*Object, Stop) {
 	}
 }
 
+// HandleRemoteStop handles a signal received from the VM's control flow
+// channel in the same way that Perform does. The result is (result, NoStop) if
+// the coroutine should continue normally, which includes pausing until resumed,
+// otherwise the control flow to return. Blocking operations should use this to
+// handle signals received while they wait.
+func (vm *VM) HandleRemoteStop(stop RemoteStop, result *Object) (*Object, Stop) {
+	switch stop.Control {
+	case NoStop, ResumeStop:
+		// Yield, first running any messages deferred until now.
+		if vm.RunDeferred() == ExitStop {
+			return nil, ExitStop
+		}
+		runtime.Gosched()
+	case ContinueStop, BreakStop, ReturnStop, ExceptionStop, ExitStop:
+		// Return the stop.
+		return stop.Result, stop.Control
+	case PauseStop:
+		if vm.RunDeferred() == ExitStop {
+			return nil, ExitStop
+		}
+		return vm.doPause(result)
+	default:
+		panic(fmt.Sprintf("invalid status in received stop %#v", stop))
+	}
+	return result, NoStop
+}
+
 // ObjectFor is an Object method.
 //
 // for performs a loop with a counter. For example, to print each number from 1
@@ -1,5 +1,7 @@
 package internal
 
+import "fmt"
+
 // A Coroutine holds control flow and debugging for a single Io coroutine.
 type Coroutine struct {
 	// Control is the control flow channel for the VM associated with this
@@ -12,10 +14,76 @@ type Coroutine struct {
 // RunCoro starts an inactive coroutine by activating its main slot. It should
 // be used in a go statement.
 func RunCoro(vm *VM) {
+	vm.nesting++
 	vm.Perform(vm.Coro, vm.Coro, vm.IdentMessage("main"))
+	vm.nesting--
+	vm.RunDeferred()
 	vm.Sched.Finish(vm)
 }
 
+// deferredMessage is a message queued by performLater.
+type deferredMessage struct {
+	target, locals *Object
+	msg            *Message
+}
+
+// Defer queues msg to be sent to target with the given locals once the
+// coroutine's current evaluation completes or the coroutine next yields or
+// pauses, whichever is first.
+func (vm *VM) Defer(target, locals *Object, msg *Message) {
+	vm.deferred = append(vm.deferred, deferredMessage{target: target, locals: locals, msg: msg})
+}
+
+// RunDeferred performs the coroutine's deferred messages in the order they were
+// queued, including any queued while doing so. If a deferred message raises an
+// exception, it is passed to System deferredExceptionHandler, and the
+// remaining messages still run. An ExitStop stops processing immediately and
+// is returned; otherwise the result is NoStop.
+func (vm *VM) RunDeferred() Stop {
+	for len(vm.deferred) > 0 {
+		d := vm.deferred[0]
+		vm.deferred[0] = deferredMessage{}
+		vm.deferred = vm.deferred[1:]
+		r, stop := vm.Perform(d.target, d.locals, d.msg)
+		switch stop {
+		case NoStop, ContinueStop, BreakStop, ReturnStop: // do nothing
+		case ExceptionStop:
+			vm.deferredException(r)
+		case ExitStop:
+			vm.deferred = nil
+			return ExitStop
+		default:
+			panic(fmt.Errorf("iolang: invalid Stop: %w", stop.Err()))
+		}
+	}
+	vm.deferred = nil
+	return NoStop
+}
+
+// doTopLevel evaluates msg in the Lobby, then runs any deferred messages
+// unless this is nested within another top-level evaluation, e.g. when an
+// addon's source is loaded while Io code is running.
+func (vm *VM) doTopLevel(msg *Message) (*Object, Stop) {
+	vm.nesting++
+	r, stop := vm.DoMessage(msg, vm.Lobby)
+	vm.nesting--
+	if vm.nesting == 0 && vm.RunDeferred() == ExitStop && stop != ExitStop {
+		return nil, ExitStop
+	}
+	return r, stop
+}
+
+// deferredException activates System deferredExceptionHandler with an
+// exception raised by a deferred message.
+func (vm *VM) deferredException(exc *Object) {
+	sys, ok := vm.GetLocalSlot(vm.Core, "System")
+	if !ok {
+		fmt.Fprintln(vm.Stderr, "iolang: exception in deferred message:", vm.AsString(exc))
+		return
+	}
+	vm.Perform(sys, sys, vm.IdentMessage("deferredExceptionHandler", vm.CachedMessage(exc)))
+}
+
 // run is a temporary proxy to RunCoro(vm).
 func (vm *VM) run() {
 	RunCoro(vm)
@@ -58,8 +126,13 @@ func (vm *VM) VMFor(coro *Object) *VM {
 		Sched:       vm.Sched,
 		Control:     c.Control,
 		Coro:        coro,
+		Stdout:      vm.Stdout,
+		Stderr:      vm.Stderr,
 		addonmaps:   vm.addonmaps,
 		numberCache: vm.numberCache,
+		regexps:     vm.regexps,
+		rec:         vm.rec,
+		rand:        vm.rand,
 		StartTime:   vm.StartTime,
 	}
 	c.Debug = &r.Debug
@@ -1,6 +1,12 @@
 package internal
 
-import "fmt"
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
 
 // An Exception is an Io exception.
 type Exception struct {
@@ -23,6 +29,76 @@ func (e Exception) Unwrap() error {
 	return e.Err
 }
 
+// WriteStack writes a description of each frame of the exception's stack to
+// w, most recent call last. Each frame gives the message name, label, and
+// line. If the frame's label names a readable file, the offending source line
+// follows, with a caret under the message's column.
+func (e Exception) WriteStack(w io.Writer) error {
+	src := map[string][]string{}
+	for i := len(e.Stack) - 1; i >= 0; i-- {
+		m := e.Stack[i]
+		var err error
+		if m.IsStart() {
+			_, err = fmt.Fprintf(w, "\t%s\t%s:%d\n", m.Name(), m.Label, m.Line)
+		} else {
+			_, err = fmt.Fprintf(w, "\t%s %s\t%s:%d\n", m.Prev.Name(), m.Name(), m.Label, m.Line)
+		}
+		if err != nil {
+			return err
+		}
+		lines, ok := src[m.Label]
+		if !ok {
+			lines = sourceLines(m.Label)
+			src[m.Label] = lines
+		}
+		if m.Line < 1 || m.Line > len(lines) {
+			continue
+		}
+		line := lines[m.Line-1]
+		if _, err := fmt.Fprintf(w, "\t\t%s\n\t\t%s^\n", line, caretPrefix(line, m.Col)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sourceLines returns the lines of the file named by label, or nil if it
+// cannot be read.
+func sourceLines(label string) []string {
+	f, err := os.Open(label)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	var lines []string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		lines = append(lines, strings.TrimRight(sc.Text(), "\r"))
+	}
+	if sc.Err() != nil {
+		return nil
+	}
+	return lines
+}
+
+// caretPrefix returns the whitespace to print before a caret under the
+// col'th rune of line, keeping tabs so that the caret aligns.
+func caretPrefix(line string, col int) string {
+	var b strings.Builder
+	for _, r := range line {
+		if col <= 1 {
+			break
+		}
+		col--
+		if r == '\t' {
+			b.WriteByte('\t')
+		} else {
+			b.WriteByte(' ')
+		}
+	}
+	return b.String()
+}
+
 // tagException is the Tag type for Exception objects.
 type tagException struct{}
 
@@ -54,6 +130,15 @@ func (vm *VM) NewException(err error) *Object {
 	return vm.ObjectWith(Slots{"coroutine": vm.Coro}, vm.CoreProto("Exception"), Exception{Err: err}, ExceptionTag)
 }
 
+// newExceptionFrom creates a new Exception object with the given error whose
+// proto is proto, if it is an Exception, or Core Exception otherwise.
+func (vm *VM) newExceptionFrom(proto *Object, err error) *Object {
+	if proto.Tag() != ExceptionTag {
+		return vm.NewException(err)
+	}
+	return vm.ObjectWith(Slots{"coroutine": vm.Coro}, []*Object{proto}, Exception{Err: err}, ExceptionTag)
+}
+
 // NewExceptionf creates a new Io Exception with the given formatted error
 // message.
 func (vm *VM) NewExceptionf(format string, args ...interface{}) *Object {
@@ -70,7 +155,11 @@ func (vm *VM) RaiseExceptionf(format string, args ...interface{}) *Object {
 	return vm.Raise(vm.NewExceptionf(format, args...))
 }
 
-// Raise raises an exception and returns the object.
+// Raise raises an object as an exception and returns it, for use as the
+// result of a CFunction: return vm.Raise(obj). The object may be an instance
+// of a custom exception proto, e.g. a clone of Exception created in Io. If it
+// is an Exception, each message through which the exception propagates is
+// recorded in its stack, as with Exception raise.
 func (vm *VM) Raise(exc *Object) *Object {
 	return vm.Stop(exc, ExceptionStop)
 }
@@ -97,6 +186,7 @@ func (vm *VM) initException() {
 		"pass":            vm.NewCFunction(ExceptionPass, ExceptionTag),
 		"raise":           vm.NewCFunction(ExceptionRaise, nil),
 		"raiseFrom":       vm.NewCFunction(ExceptionRaiseFrom, nil),
+		"showStack":       vm.NewCFunction(ExceptionShowStack, ExceptionTag),
 		"stack":           vm.NewCFunction(ExceptionStack, ExceptionTag),
 		"type":            vm.NewString("Exception"),
 	}
@@ -122,7 +212,9 @@ func ExceptionPass(vm *VM, target, locals *Object, msg *Message) *Object {
 
 // ExceptionRaise is an Exception method.
 //
-// raise creates an exception with the given error message and raises it.
+// raise creates an exception with the given error message and raises it. The
+// new exception is a clone of the receiver, so custom exception protos can be
+// raised directly, e.g. ValueError := Exception clone; ValueError raise("bad").
 func ExceptionRaise(vm *VM, target, locals *Object, msg *Message) *Object {
 	s, exc, stop := msg.StringArgAt(vm, locals, 0)
 	if stop != NoStop {
@@ -132,7 +224,7 @@ func ExceptionRaise(vm *VM, target, locals *Object, msg *Message) *Object {
 	if stop != NoStop {
 		vm.Stop(nested, stop)
 	}
-	e := vm.NewExceptionf("%v", s)
+	e := vm.newExceptionFrom(target, fmt.Errorf("%v", s))
 	vm.SetSlot(e, "nestedException", nested)
 	return vm.Raise(e)
 }
@@ -153,7 +245,7 @@ func ExceptionRaiseFrom(vm *VM, target, locals *Object, msg *Message) *Object {
 	if stop != NoStop {
 		return vm.Stop(nested, stop)
 	}
-	e := vm.NewExceptionf("%v", s)
+	e := vm.newExceptionFrom(target, fmt.Errorf("%v", s))
 	vm.SetSlots(e, Slots{"nestedException": nested, "originalCall": call})
 	return vm.Raise(e)
 }
@@ -174,12 +266,27 @@ func ExceptionSetError(vm *VM, target, locals *Object, msg *Message) *Object {
 	return target
 }
 
+// ExceptionShowStack is an Exception method.
+//
+// showStack prints the exception's message stack, most recent call last. Where
+// the source file of a frame is available, the offending line is printed with
+// a caret under the message.
+func ExceptionShowStack(vm *VM, target, locals *Object, msg *Message) *Object {
+	target.Lock()
+	e := target.Value.(Exception)
+	target.Unlock()
+	if err := e.WriteStack(vm.Stdout); err != nil {
+		return vm.IoError(err)
+	}
+	return target
+}
+
 // ExceptionStack is an Exception method.
 //
 // stack returns the message stack of the exception.
 func ExceptionStack(vm *VM, target, locals *Object, msg *Message) *Object {
-	e := target.Value.(*Exception)
 	target.Lock()
+	e := target.Value.(Exception)
 	l := make([]*Object, len(e.Stack))
 	for i, m := range e.Stack {
 		l[i] = vm.MessageObject(m)
@@ -0,0 +1,98 @@
+package internal_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/zephyrtronium/iolang"
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+// TestRaise tests that objects raised from Go with VM.Raise propagate as
+// exceptions and capture their stacks.
+func TestRaise(t *testing.T) {
+	vm := testutils.VM()
+	exc := vm.MustDoString(`Exception clone clone`)
+	vm.AddMethod(vm.BaseObject, "testRaise", func(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+		return vm.Raise(exc)
+	}, nil)
+	defer vm.RemoveSlot(vm.BaseObject, "testRaise")
+	r, stop := vm.DoString(`Object testRaise`, "TestRaise")
+	if stop != iolang.ExceptionStop {
+		t.Fatalf("wrong control flow: want %v, got %v (%v)", iolang.ExceptionStop, stop, r)
+	}
+	if r != exc {
+		t.Errorf("wrong object raised: want %v, got %v", exc, r)
+	}
+	r.Lock()
+	e, ok := r.Value.(iolang.Exception)
+	r.Unlock()
+	if !ok {
+		t.Fatalf("raised object has value %T, not Exception", r.Value)
+	}
+	if len(e.Stack) == 0 {
+		t.Errorf("no stack captured")
+	}
+}
+
+// TestExceptionMethods tests Exception methods by executing Io scripts.
+func TestExceptionMethods(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]map[string]testutils.SourceTestCase{
+		"raise": {
+			"error":  {Source: `try(Exception raise("bad")) error`, Pass: testutils.PassEqual(vm.NewString("bad"))},
+			"custom": {Source: `Object clone do(E := Exception clone; e := try(E raise("bad"))) do(r := e isKindOf(E)) r`, Pass: testutils.PassIdentical(vm.True)},
+			"proto":  {Source: `try(Exception raise("bad")) proto == Exception`, Pass: testutils.PassIdentical(vm.True)},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			for name, s := range c {
+				t.Run(name, s.TestFunc("TestExceptionMethods"))
+			}
+		})
+	}
+}
+
+// TestExceptionWriteStack tests that exceptions raised from files describe
+// their stacks with source lines.
+func TestExceptionWriteStack(t *testing.T) {
+	vm := testutils.VM()
+	dir, err := ioutil.TempDir("", "iolang-stack")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "stack.io")
+	src := "Object clone do(\n\tf := method(Exception raise(\"boom\"))\n\tf\n)\n"
+	if err := ioutil.WriteFile(path, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	r, stop := vm.RunFile(path)
+	if stop != iolang.ExceptionStop {
+		t.Fatalf("wrong control flow: want %v, got %v (%v)", iolang.ExceptionStop, stop, r)
+	}
+	r.Lock()
+	e, ok := r.Value.(iolang.Exception)
+	r.Unlock()
+	if !ok {
+		t.Fatalf("raised object has value %T, not Exception", r.Value)
+	}
+	if e.Error() != "boom" {
+		t.Errorf("wrong message: want %q, got %q", "boom", e.Error())
+	}
+	var b bytes.Buffer
+	if err := e.WriteStack(&b); err != nil {
+		t.Fatal(err)
+	}
+	want := fmt.Sprintf("\tclone do\t%[1]s:1\n\t\tObject clone do(\n\t\t             ^\n"+
+		"\tf\t%[1]s:3\n\t\t\tf\n\t\t\t^\n"+
+		"\tException raise\t%[1]s:2\n\t\t\tf := method(Exception raise(\"boom\"))\n\t\t\t                      ^\n", path)
+	if b.String() != want {
+		t.Errorf("wrong stack:\nwant %q\ngot  %q", want, b.String())
+	}
+}
@@ -0,0 +1,296 @@
+package internal
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// gcb is a Grapheme_Cluster_Break property value as defined in UAX #29.
+type gcb int
+
+const (
+	gcbOther gcb = iota
+	gcbCR
+	gcbLF
+	gcbControl
+	gcbExtend
+	gcbZWJ
+	gcbRegionalIndicator
+	gcbPrepend
+	gcbSpacingMark
+	gcbL
+	gcbV
+	gcbT
+	gcbLV
+	gcbLVT
+)
+
+// Graphemes splits s into extended grapheme clusters following the rules of
+// UAX #29. Character properties are derived from the tables of the unicode
+// package, so the segmentation tracks the Unicode version given by
+// unicode.Version, except that Prepend and Extended_Pictographic are fixed
+// lists taken from Unicode 13.0. Invalid UTF-8 bytes each form their own
+// cluster.
+func Graphemes(s string) []string {
+	var r []string
+	for len(s) > 0 {
+		n := nextGrapheme(s)
+		r = append(r, s[:n])
+		s = s[n:]
+	}
+	return r
+}
+
+// nextGrapheme returns the length in bytes of the first grapheme cluster in s,
+// which must be non-empty.
+func nextGrapheme(s string) int {
+	c, n := utf8.DecodeRuneInString(s)
+	if c == utf8.RuneError && n <= 1 {
+		return n
+	}
+	prev := graphemeBreak(c)
+	// pict is whether the cluster so far ends with Extended_Pictographic
+	// followed by any number of Extend, and zwj is whether it ends with such
+	// a sequence followed by ZWJ, for GB11. ri counts consecutive regional
+	// indicators, for GB12 and GB13.
+	pict := unicode.Is(extendedPictographic, c)
+	zwj := false
+	ri := 0
+	if prev == gcbRegionalIndicator {
+		ri = 1
+	}
+	for n < len(s) {
+		c, k := utf8.DecodeRuneInString(s[n:])
+		if c == utf8.RuneError && k <= 1 {
+			return n
+		}
+		cur := graphemeBreak(c)
+		isPict := unicode.Is(extendedPictographic, c)
+		if graphemeBoundary(prev, cur, zwj && isPict, ri) {
+			return n
+		}
+		zwj = pict && cur == gcbZWJ
+		pict = isPict || pict && cur == gcbExtend
+		if cur == gcbRegionalIndicator {
+			ri++
+		} else {
+			ri = 0
+		}
+		prev = cur
+		n += k
+	}
+	return n
+}
+
+// graphemeBoundary determines whether there is a grapheme cluster boundary
+// between characters with properties prev and cur. emoji is whether GB11
+// applies, and ri is the number of regional indicators immediately preceding
+// cur.
+func graphemeBoundary(prev, cur gcb, emoji bool, ri int) bool {
+	switch {
+	case prev == gcbCR && cur == gcbLF: // GB3
+		return false
+	case prev == gcbCR, prev == gcbLF, prev == gcbControl: // GB4
+		return true
+	case cur == gcbCR, cur == gcbLF, cur == gcbControl: // GB5
+		return true
+	case prev == gcbL && (cur == gcbL || cur == gcbV || cur == gcbLV || cur == gcbLVT): // GB6
+		return false
+	case (prev == gcbLV || prev == gcbV) && (cur == gcbV || cur == gcbT): // GB7
+		return false
+	case (prev == gcbLVT || prev == gcbT) && cur == gcbT: // GB8
+		return false
+	case cur == gcbExtend, cur == gcbZWJ: // GB9
+		return false
+	case cur == gcbSpacingMark: // GB9a
+		return false
+	case prev == gcbPrepend: // GB9b
+		return false
+	case emoji: // GB11
+		return false
+	case prev == gcbRegionalIndicator && cur == gcbRegionalIndicator: // GB12, GB13
+		return ri%2 == 0
+	}
+	return true // GB999
+}
+
+// graphemeBreak returns the Grapheme_Cluster_Break property of c.
+func graphemeBreak(c rune) gcb {
+	switch {
+	case c == '\r':
+		return gcbCR
+	case c == '\n':
+		return gcbLF
+	case c == 0x200d:
+		return gcbZWJ
+	case 0x1f1e6 <= c && c <= 0x1f1ff:
+		return gcbRegionalIndicator
+	case 0x1f3fb <= c && c <= 0x1f3ff:
+		// Emoji modifiers are Extend since Unicode 11.0.
+		return gcbExtend
+	case unicode.Is(graphemePrepend, c):
+		return gcbPrepend
+	case unicode.In(c, unicode.Mn, unicode.Me, unicode.Other_Grapheme_Extend):
+		return gcbExtend
+	case c == 0x0e33, c == 0x0eb3:
+		return gcbSpacingMark
+	case unicode.Is(unicode.Mc, c):
+		if unicode.Is(spacingMarkExceptions, c) {
+			return gcbOther
+		}
+		return gcbSpacingMark
+	case unicode.In(c, unicode.Cc, unicode.Zl, unicode.Zp, unicode.Cf):
+		return gcbControl
+	}
+	return hangulBreak(c)
+}
+
+// hangulBreak returns the Grapheme_Cluster_Break property of c if it is a
+// Hangul jamo or syllable, or gcbOther otherwise.
+func hangulBreak(c rune) gcb {
+	switch {
+	case 0x1100 <= c && c <= 0x115f, 0xa960 <= c && c <= 0xa97c:
+		return gcbL
+	case 0x1160 <= c && c <= 0x11a7, 0xd7b0 <= c && c <= 0xd7c6:
+		return gcbV
+	case 0x11a8 <= c && c <= 0x11ff, 0xd7cb <= c && c <= 0xd7fb:
+		return gcbT
+	case 0xac00 <= c && c <= 0xd7a3:
+		// Syllables with no trailing consonant occur every 28 code points.
+		if (c-0xac00)%28 == 0 {
+			return gcbLV
+		}
+		return gcbLVT
+	}
+	return gcbOther
+}
+
+// graphemePrepend is the set of characters with Grapheme_Cluster_Break=Prepend.
+var graphemePrepend = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0600, Hi: 0x0605, Stride: 1},
+		{Lo: 0x06dd, Hi: 0x06dd, Stride: 1},
+		{Lo: 0x070f, Hi: 0x070f, Stride: 1},
+		{Lo: 0x08e2, Hi: 0x08e2, Stride: 1},
+		{Lo: 0x0d4e, Hi: 0x0d4e, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x110bd, Hi: 0x110bd, Stride: 1},
+		{Lo: 0x110cd, Hi: 0x110cd, Stride: 1},
+		{Lo: 0x111c2, Hi: 0x111c3, Stride: 1},
+		{Lo: 0x1193f, Hi: 0x1193f, Stride: 1},
+		{Lo: 0x11941, Hi: 0x11941, Stride: 1},
+		{Lo: 0x11a3a, Hi: 0x11a3a, Stride: 1},
+		{Lo: 0x11a84, Hi: 0x11a89, Stride: 1},
+		{Lo: 0x11d46, Hi: 0x11d46, Stride: 1},
+	},
+}
+
+// spacingMarkExceptions is the set of characters in category Mc which are not
+// Grapheme_Cluster_Break=SpacingMark.
+var spacingMarkExceptions = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x102b, Hi: 0x102c, Stride: 1},
+		{Lo: 0x1038, Hi: 0x1038, Stride: 1},
+		{Lo: 0x1062, Hi: 0x1064, Stride: 1},
+		{Lo: 0x1067, Hi: 0x106d, Stride: 1},
+		{Lo: 0x1083, Hi: 0x1083, Stride: 1},
+		{Lo: 0x1087, Hi: 0x108c, Stride: 1},
+		{Lo: 0x108f, Hi: 0x108f, Stride: 1},
+		{Lo: 0x109a, Hi: 0x109c, Stride: 1},
+		{Lo: 0x1a61, Hi: 0x1a61, Stride: 1},
+		{Lo: 0x1a63, Hi: 0x1a64, Stride: 1},
+		{Lo: 0xaa7b, Hi: 0xaa7b, Stride: 1},
+		{Lo: 0xaa7d, Hi: 0xaa7d, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x11720, Hi: 0x11721, Stride: 1},
+	},
+}
+
+// extendedPictographic is the set of characters with the
+// Extended_Pictographic property.
+var extendedPictographic = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x00a9, Hi: 0x00a9, Stride: 1},
+		{Lo: 0x00ae, Hi: 0x00ae, Stride: 1},
+		{Lo: 0x203c, Hi: 0x203c, Stride: 1},
+		{Lo: 0x2049, Hi: 0x2049, Stride: 1},
+		{Lo: 0x2122, Hi: 0x2122, Stride: 1},
+		{Lo: 0x2139, Hi: 0x2139, Stride: 1},
+		{Lo: 0x2194, Hi: 0x2199, Stride: 1},
+		{Lo: 0x21a9, Hi: 0x21aa, Stride: 1},
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x2328, Hi: 0x2328, Stride: 1},
+		{Lo: 0x2388, Hi: 0x2388, Stride: 1},
+		{Lo: 0x23cf, Hi: 0x23cf, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23f3, Stride: 1},
+		{Lo: 0x23f8, Hi: 0x23fa, Stride: 1},
+		{Lo: 0x24c2, Hi: 0x24c2, Stride: 1},
+		{Lo: 0x25aa, Hi: 0x25ab, Stride: 1},
+		{Lo: 0x25b6, Hi: 0x25b6, Stride: 1},
+		{Lo: 0x25c0, Hi: 0x25c0, Stride: 1},
+		{Lo: 0x25fb, Hi: 0x25fe, Stride: 1},
+		{Lo: 0x2600, Hi: 0x2605, Stride: 1},
+		{Lo: 0x2607, Hi: 0x2612, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2685, Stride: 1},
+		{Lo: 0x2690, Hi: 0x2705, Stride: 1},
+		{Lo: 0x2708, Hi: 0x2712, Stride: 1},
+		{Lo: 0x2714, Hi: 0x2714, Stride: 1},
+		{Lo: 0x2716, Hi: 0x2716, Stride: 1},
+		{Lo: 0x271d, Hi: 0x271d, Stride: 1},
+		{Lo: 0x2721, Hi: 0x2721, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x2733, Hi: 0x2734, Stride: 1},
+		{Lo: 0x2744, Hi: 0x2744, Stride: 1},
+		{Lo: 0x2747, Hi: 0x2747, Stride: 1},
+		{Lo: 0x274c, Hi: 0x274c, Stride: 1},
+		{Lo: 0x274e, Hi: 0x274e, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2763, Hi: 0x2767, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27a1, Hi: 0x27a1, Stride: 1},
+		{Lo: 0x27b0, Hi: 0x27b0, Stride: 1},
+		{Lo: 0x27bf, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+		{Lo: 0x2b05, Hi: 0x2b07, Stride: 1},
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b50, Stride: 1},
+		{Lo: 0x2b55, Hi: 0x2b55, Stride: 1},
+		{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+		{Lo: 0x303d, Hi: 0x303d, Stride: 1},
+		{Lo: 0x3297, Hi: 0x3297, Stride: 1},
+		{Lo: 0x3299, Hi: 0x3299, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f000, Hi: 0x1f0ff, Stride: 1},
+		{Lo: 0x1f10d, Hi: 0x1f10f, Stride: 1},
+		{Lo: 0x1f12f, Hi: 0x1f12f, Stride: 1},
+		{Lo: 0x1f16c, Hi: 0x1f171, Stride: 1},
+		{Lo: 0x1f17e, Hi: 0x1f17f, Stride: 1},
+		{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1},
+		{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1},
+		{Lo: 0x1f1ad, Hi: 0x1f1e5, Stride: 1},
+		{Lo: 0x1f201, Hi: 0x1f20f, Stride: 1},
+		{Lo: 0x1f21a, Hi: 0x1f21a, Stride: 1},
+		{Lo: 0x1f22f, Hi: 0x1f22f, Stride: 1},
+		{Lo: 0x1f232, Hi: 0x1f23a, Stride: 1},
+		{Lo: 0x1f23c, Hi: 0x1f23f, Stride: 1},
+		{Lo: 0x1f249, Hi: 0x1f3fa, Stride: 1},
+		{Lo: 0x1f400, Hi: 0x1f53d, Stride: 1},
+		{Lo: 0x1f546, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f680, Hi: 0x1f6ff, Stride: 1},
+		{Lo: 0x1f774, Hi: 0x1f77f, Stride: 1},
+		{Lo: 0x1f7d5, Hi: 0x1f7ff, Stride: 1},
+		{Lo: 0x1f80c, Hi: 0x1f80f, Stride: 1},
+		{Lo: 0x1f848, Hi: 0x1f84f, Stride: 1},
+		{Lo: 0x1f85a, Hi: 0x1f85f, Stride: 1},
+		{Lo: 0x1f888, Hi: 0x1f88f, Stride: 1},
+		{Lo: 0x1f8ae, Hi: 0x1f8ff, Stride: 1},
+		{Lo: 0x1f90c, Hi: 0x1f93a, Stride: 1},
+		{Lo: 0x1f93c, Hi: 0x1f945, Stride: 1},
+		{Lo: 0x1f947, Hi: 0x1faff, Stride: 1},
+		{Lo: 0x1fc00, Hi: 0x1fffd, Stride: 1},
+	},
+}
@@ -0,0 +1,57 @@
+package internal_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zephyrtronium/iolang/internal"
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+// TestGraphemes tests that Graphemes splits strings into extended grapheme
+// clusters.
+func TestGraphemes(t *testing.T) {
+	cases := map[string]struct {
+		s    string
+		want []string
+	}{
+		"empty":     {"", nil},
+		"ascii":     {"abc", []string{"a", "b", "c"}},
+		"combining": {"e\u0301x", []string{"e\u0301", "x"}},
+		"marks":     {"a\u0308\u0304b", []string{"a\u0308\u0304", "b"}},
+		"crlf":      {"a\r\nb", []string{"a", "\r\n", "b"}},
+		"cr":        {"\r\r", []string{"\r", "\r"}},
+		"control":   {"\t\u0301", []string{"\t", "\u0301"}},
+		"flags":     {"\U0001F1FA\U0001F1F8\U0001F1EB\U0001F1F7", []string{"\U0001F1FA\U0001F1F8", "\U0001F1EB\U0001F1F7"}},
+		"oddflag":   {"\U0001F1FA\U0001F1F8\U0001F1EB", []string{"\U0001F1FA\U0001F1F8", "\U0001F1EB"}},
+		"modifier":  {"\U0001F44D\U0001F3FDa", []string{"\U0001F44D\U0001F3FD", "a"}},
+		"zwj":       {"\U0001F469\u200d\U0001F469\u200d\U0001F467!", []string{"\U0001F469\u200d\U0001F469\u200d\U0001F467", "!"}},
+		"zwjzwj":    {"\U0001F469\u200d\u200d\U0001F467", []string{"\U0001F469\u200d\u200d", "\U0001F467"}},
+		"zwjtext":   {"a\u200d\U0001F467", []string{"a\u200d", "\U0001F467"}},
+		"hangul":    {"\u1100\u1161\u11a8\uD55C", []string{"\u1100\u1161\u11a8", "\uD55C"}},
+		"spacing":   {"\u0915\u093f", []string{"\u0915\u093f"}},
+		"prepend":   {"\u0600\u0661", []string{"\u0600\u0661"}},
+		"invalid":   {"a\xffb", []string{"a", "\xff", "b"}},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := internal.Graphemes(c.s); !reflect.DeepEqual(got, c.want) {
+				t.Errorf("wrong clusters for %q: want %q, got %q", c.s, c.want, got)
+			}
+		})
+	}
+}
+
+// TestSequenceAsGraphemes tests the Sequence asGraphemes method.
+func TestSequenceAsGraphemes(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"combining": {Source: "\"e\u0301!\" asGraphemes", Pass: testutils.PassEqual(vm.NewList(vm.NewString("e\u0301"), vm.NewString("!")))},
+		"flag":      {Source: "\"\U0001F1EB\U0001F1F7\" asGraphemes size", Pass: testutils.PassEqual(vm.NewNumber(1))},
+		"utf16":     {Source: "\"e\u0301!\" asUTF16 asGraphemes size", Pass: testutils.PassEqual(vm.NewNumber(2))},
+		"empty":     {Source: `"" asGraphemes`, Pass: testutils.PassEqual(vm.NewList())},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestSequenceAsGraphemes/"+name))
+	}
+}
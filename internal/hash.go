@@ -0,0 +1,133 @@
+package internal
+
+import (
+	"encoding/binary"
+	"hash"
+	"hash/fnv"
+	"math"
+	"reflect"
+)
+
+// HashObject returns a hash of obj which is consistent with ==: Numbers,
+// Sequences, and Lists of such values which are equal have equal hashes.
+// Sequence comparison converts the other sequence's items to the receiver's
+// item type, so e.g. a uint8 0 equals a uint16 256. Sequences therefore hash
+// only by their lengths and the low byte of each item truncated to an integer,
+// which is all that comparison in the narrowest item type preserves. A string
+// hashes the same regardless of its encoding or mutability. Hashes of these
+// types are stable across VMs and program runs. Any other object hashes by its
+// identity, which is stable only for the object's lifetime.
+func (vm *VM) HashObject(obj *Object) uint64 {
+	h := fnv.New64a()
+	vm.hashInto(h, obj, nil)
+	return h.Sum64()
+}
+
+// hashInto writes a representation of obj to h. seen tracks the lists being
+// hashed so that lists which contain themselves terminate.
+func (vm *VM) hashInto(h hash.Hash64, obj *Object, seen map[*Object]bool) {
+	var b [9]byte
+	switch obj.Tag() {
+	case NumberTag:
+		b[0] = 'n'
+		binary.LittleEndian.PutUint64(b[1:], hashFloat(obj.Value.(float64)))
+		h.Write(b[:])
+	case SequenceTag:
+		s := holdSeq(obj)
+		n := s.Len()
+		b[0] = 's'
+		binary.LittleEndian.PutUint64(b[1:], uint64(n))
+		h.Write(b[:])
+		h.Write(seqLowBytes(s))
+		unholdSeq(s.Mutable, obj)
+	case ListTag:
+		b[0] = 'l'
+		if seen[obj] {
+			h.Write(b[:1])
+			return
+		}
+		if seen == nil {
+			seen = make(map[*Object]bool)
+		}
+		seen[obj] = true
+		obj.Lock()
+		l := append([]*Object(nil), obj.Value.([]*Object)...)
+		obj.Unlock()
+		binary.LittleEndian.PutUint64(b[1:], uint64(len(l)))
+		h.Write(b[:])
+		for _, v := range l {
+			vm.hashInto(h, v, seen)
+		}
+		delete(seen, obj)
+	default:
+		b[0] = 'o'
+		binary.LittleEndian.PutUint64(b[1:], uint64(obj.UniqueID()))
+		h.Write(b[:])
+	}
+}
+
+// seqLowBytes returns the low byte of each item of s, truncating
+// floating-point items to integers first. Items which don't fit in an int64,
+// including NaNs and infinities, become zero.
+func seqLowBytes(s Sequence) []byte {
+	switch v := s.Value.(type) {
+	case []byte:
+		return v
+	case []int8:
+		r := make([]byte, len(v))
+		for i, x := range v {
+			r[i] = byte(x)
+		}
+		return r
+	case []float32:
+		r := make([]byte, len(v))
+		for i, x := range v {
+			r[i] = floatLowByte(float64(x))
+		}
+		return r
+	case []float64:
+		r := make([]byte, len(v))
+		for i, x := range v {
+			r[i] = floatLowByte(x)
+		}
+		return r
+	}
+	sv := reflect.ValueOf(s.Value)
+	r := make([]byte, sv.Len())
+	for i := range r {
+		x := sv.Index(i)
+		switch x.Kind() {
+		case reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			r[i] = byte(x.Uint())
+		default:
+			r[i] = byte(x.Int())
+		}
+	}
+	return r
+}
+
+// floatLowByte returns the low byte of x truncated to an integer, or zero if
+// x does not fit in an int64.
+func floatLowByte(x float64) byte {
+	if !(x > -1<<63 && x < 1<<63) {
+		return 0
+	}
+	return byte(int64(x))
+}
+
+// hashFloat returns the bits of x with all zeros and all NaNs made equal.
+func hashFloat(x float64) uint64 {
+	if x == 0 {
+		return 0
+	}
+	if math.IsNaN(x) {
+		return math.Float64bits(math.NaN())
+	}
+	return math.Float64bits(x)
+}
+
+// hashNumber converts a hash to a Number. Only the top 53 bits are kept so
+// that the Number holds the hash exactly.
+func (vm *VM) hashNumber(h uint64) *Object {
+	return vm.NewNumber(float64(h >> 11))
+}
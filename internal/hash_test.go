@@ -0,0 +1,36 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+// TestHashObject tests that equal objects have equal hashes.
+func TestHashObject(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string][2]string{
+		"number":   {`1`, `1`},
+		"zero":     {`0`, `-0`},
+		"mutable":  {`"abc"`, `"abc" asMutable`},
+		"encoding": {`"abc"`, `"abc" asUTF16`},
+		"list":     {`list(1, "a", list(2))`, `list(1, "a" asMutable, list(2))`},
+		"itemType": {`list(0) asSequence("uint8")`, `list(256) asSequence("uint16")`},
+		"signed":   {`list(255) asSequence("uint8")`, `list(-1) asSequence("int8")`},
+		"float":    {`list(1) asSequence("int32")`, `list(1.5) asSequence("float64")`},
+		"float32":  {`list(0.1) asSequence("float32")`, `list(0.1) asSequence("float64")`},
+		"seqList":  {`list(list(0) asSequence("uint8"))`, `list(list(256) asSequence("uint16"))`},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			x := vm.MustDoString(c[0])
+			y := vm.MustDoString(c[1])
+			if !vm.AsBool(vm.MustDoString("(" + c[0] + ") == (" + c[1] + ")")) {
+				t.Fatalf("%s != %s", c[0], c[1])
+			}
+			if a, b := vm.HashObject(x), vm.HashObject(y); a != b {
+				t.Errorf("%s hashes to %x but %s hashes to %x", c[0], a, c[1], b)
+			}
+		})
+	}
+}
@@ -81,6 +81,7 @@ Object do(
 				s := Sequence clone
 				descs keys sortInPlace foreach(k,
 					s appendSeq("  ", k alignLeft(16), " = ", descs at(k), "\n")
+					getSlot("p") docOf(k) ifNonNilEval(s appendSeq("      ", getSlot("p") docOf(k), "\n"))
 				)
 				slot println
 				s println
@@ -95,6 +96,7 @@ Object do(
 		kw ifNonNil(descs = descs select(k, v, k asMutable lowercase containsSeq(kw)))
 		descs keys sortInPlace foreach(k,
 			s appendSeq("  ", k alignLeft(16), " = ", descs at(k), "\n")
+			getSlot("self") docOf(k) ifNonNilEval(s appendSeq("      ", getSlot("self") docOf(k), "\n"))
 		)
 		s
 	)
@@ -25,6 +25,7 @@ Sequence do(
 	alignCenter := method(w, pad, alignRight(((size + w)/2) floor, pad) alignLeftInPlace(w, pad))
 
 	asCapitalized := method(if(isMutable, capitalize, asMutable capitalize asSymbol))
+	asCapitalizedWords := method(asMutable capitalizeWords asSymbol)
 	asLowercase := method(asMutable lowercase asSymbol)
 	asUppercase := method(asMutable uppercase asSymbol)
 
@@ -78,10 +79,6 @@ Sequence do(
 		x repeat(r append(nil))
 		r
 	)
-	repeated := method(n,
-		s := Sequence clone
-		n repeat(s appendSeq(self))
-	)
 	reverse := method(self asMutable reverseInPlace)
 	sizeInBytes := method(size * itemSize)
 	slicesBetween := method(start, end,
@@ -10,7 +10,6 @@ List do(
 	copy := method(l, empty appendSeq(l))
 	itemCopy := method(List clone copy(self))
 	sort := method(List clone copy(self) sortInPlace)
-	sortBy := method(b, List clone copy(self) sortInPlaceBy(getSlot("b")))
 	reverse := method(List clone copy(self) reverseInPlace)
 
 	unique := method(
@@ -20,8 +19,6 @@ List do(
 	uniqueCount := method(unique map(v, list(v, select(== item) size)))
 
 	intersect := method(l, l select(v, contains(v)))
-	difference := method(l, select(v, l contains(v) not))
-	union := method(l, itemCopy appendSeq(l difference(self)))
 
 	reduce := method(
 		argc := call argCount
@@ -59,15 +59,6 @@ Map do(
 		)
 	)
 
-	merge := method(other,
-		self clone mergeInPlace(other)
-	)
-	mergeInPlace := method(other,
-		k := keys
-		v := keys map(x, other at(x))
-		addKeysAndValues(k, v)
-	)
-
 	reverseMap := method(
 		k := keys
 		v := k map(x, self at(x))
@@ -26,6 +26,11 @@ System do(
         opts
     )
 
+    deferredExceptionHandler := method(exc,
+        ("Exception in deferred message: " .. exc error) println
+        exc showStack
+    )
+
     userInterruptHandler := method(
         "\nreceived interrupt; exiting" println
         self exit
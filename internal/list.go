@@ -2,6 +2,8 @@ package internal
 
 import (
 	"fmt"
+	"math"
+	"reflect"
 	"sort"
 	"strings"
 	"sync"
@@ -61,34 +63,57 @@ func (vm *VM) initList() {
 		"append":              vm.NewCFunction(ListAppend, ListTag),
 		"appendIfAbsent":      vm.NewCFunction(ListAppendIfAbsent, ListTag),
 		"appendSeq":           vm.NewCFunction(ListAppendSeq, ListTag),
+		"asMapWith":           vm.NewCFunction(ListAsMapWith, ListTag),
+		"asSequence":          vm.NewCFunction(ListAsSequence, ListTag),
+		"asSortedList":        vm.NewCFunction(ListAsSortedList, ListTag),
 		"asString":            vm.NewCFunction(ListAsString, ListTag),
 		"at":                  vm.NewCFunction(ListAt, ListTag),
 		"atInsert":            vm.NewCFunction(ListAtInsert, ListTag),
 		"atPut":               vm.NewCFunction(ListAtPut, ListTag),
+		"binarySearch":        vm.NewCFunction(ListBinarySearch, ListTag),
 		"capacity":            vm.NewCFunction(ListCapacity, ListTag),
+		"chunk":               vm.NewCFunction(ListChunk, ListTag),
 		"compare":             vm.NewCFunction(ListCompare, ListTag),
 		"contains":            vm.NewCFunction(ListContains, ListTag),
 		"containsAll":         vm.NewCFunction(ListContainsAll, ListTag),
 		"containsAny":         vm.NewCFunction(ListContainsAny, ListTag),
 		"containsIdenticalTo": vm.NewCFunction(ListContainsIdenticalTo, ListTag),
+		"count":               vm.NewCFunction(ListCount, ListTag),
+		"difference":          vm.NewCFunction(ListDifference, ListTag),
+		"drop":                vm.NewCFunction(ListDrop, ListTag),
+		"dropWhile":           vm.NewCFunction(ListDropWhile, ListTag),
+		"fill":                vm.NewCFunction(ListFill, ListTag),
+		"flatMap":             vm.NewCFunction(ListFlatMap, ListTag),
 		"foreach":             vm.NewCFunction(ListForeach, ListTag),
+		"hash":                vm.NewCFunction(ListHash, ListTag),
 		"indexOf":             vm.NewCFunction(ListIndexOf, ListTag),
+		"insertionIndexOf":    vm.NewCFunction(ListInsertionIndexOf, ListTag),
+		"intersection":        vm.NewCFunction(ListIntersection, ListTag),
+		"partitionBy":         vm.NewCFunction(ListPartitionBy, ListTag),
 		"preallocateToSize":   vm.NewCFunction(ListPreallocateToSize, ListTag),
 		"prepend":             vm.NewCFunction(ListPrepend, ListTag),
+		"randomElement":       vm.NewCFunction(ListRandomElement, ListTag),
 		"remove":              vm.NewCFunction(ListRemove, ListTag),
 		"removeAll":           vm.NewCFunction(ListRemoveAll, ListTag),
 		"removeAt":            vm.NewCFunction(ListRemoveAt, ListTag),
 		"reverseForeach":      vm.NewCFunction(ListReverseForeach, ListTag),
 		"reverseInPlace":      vm.NewCFunction(ListReverseInPlace, ListTag),
+		"scan":                vm.NewCFunction(ListScan, ListTag),
 		"setSize":             vm.NewCFunction(ListSetSize, ListTag),
+		"shuffle":             vm.NewCFunction(ListShuffle, ListTag),
 		"size":                vm.NewCFunction(ListSize, ListTag),
 		"slice":               vm.NewCFunction(ListSlice, ListTag),
 		"sliceInPlace":        vm.NewCFunction(ListSliceInPlace, ListTag),
+		"sortBy":              vm.NewCFunction(ListSortBy, ListTag),
 		"sortInPlace":         vm.NewCFunction(ListSortInPlace, ListTag),
 		"sortInPlaceBy":       vm.NewCFunction(ListSortInPlaceBy, ListTag),
 		"swapIndices":         vm.NewCFunction(ListSwapIndices, ListTag),
+		"take":                vm.NewCFunction(ListTake, ListTag),
+		"takeWhile":           vm.NewCFunction(ListTakeWhile, ListTag),
 		"type":                vm.NewString("List"),
+		"union":               vm.NewCFunction(ListUnion, ListTag),
 		"with":                vm.NewCFunction(ListWith, nil),
+		"withAll":             vm.NewCFunction(ListWithAll, nil),
 	}
 	slots["empty"] = slots["removeAll"]
 	slots["exSlice"] = slots["slice"]
@@ -177,6 +202,135 @@ func ListAppendSeq(vm *VM, target, locals *Object, msg *Message) *Object {
 	return target
 }
 
+// ListAsMapWith is a List method.
+//
+// asMapWith creates a Map from the list, evaluating a key message for each
+// item with the item set to the given name and using the asString of the
+// result as that item's key. If multiple items produce the same key, the last
+// one wins, unless the optional third argument evaluates to true, in which
+// case each value in the map is a List of all items with that key.
+func ListAsMapWith(vm *VM, target, locals *Object, msg *Message) (result *Object) {
+	if msg.ArgCount() < 2 {
+		return vm.RaiseExceptionf("asMapWith requires 2 or 3 arguments")
+	}
+	vn := msg.ArgAt(0).Name()
+	ev := msg.ArgAt(1)
+	collect := false
+	if msg.ArgCount() > 2 {
+		r, stop := msg.EvalArgAt(vm, locals, 2)
+		if stop != NoStop {
+			return vm.Stop(r, stop)
+		}
+		collect = vm.AsBool(r)
+	}
+	m := make(map[string]*Object)
+	target.Lock()
+	l := target.Value.([]*Object)
+	var control Stop
+	for k := 0; k < len(l); k++ {
+		v := l[k]
+		target.Unlock()
+		vm.SetSlot(locals, vn, v)
+		result, control = ev.Eval(vm, locals)
+		if control == NoStop {
+			result, control = vm.Perform(result, locals, vm.IdentMessage("asString"))
+		}
+		switch control {
+		case NoStop:
+			result.Lock()
+			s, ok := result.Value.(Sequence)
+			var key string
+			if ok {
+				key = s.String()
+			}
+			result.Unlock()
+			if !ok {
+				return vm.RaiseExceptionf("asMapWith key for item %d cannot be converted to string", k)
+			}
+			if !collect {
+				m[key] = v
+			} else if r := m[key]; r != nil {
+				r.Value = append(r.Value.([]*Object), v)
+			} else {
+				m[key] = vm.NewList(v)
+			}
+		case ContinueStop: // do nothing
+		case BreakStop:
+			return vm.NewMap(m)
+		case ReturnStop, ExceptionStop, ExitStop:
+			return vm.Stop(result, control)
+		default:
+			panic(fmt.Sprintf("iolang: invalid Stop: %v", control))
+		}
+		target.Lock()
+		l = target.Value.([]*Object)
+	}
+	target.Unlock()
+	return vm.NewMap(m)
+}
+
+// ListAsSequence is a List method.
+//
+// asSequence creates a mutable number-encoded Sequence from a list of
+// Numbers, with the given item type, which defaults to float64. For
+// floating-point item types, this is the inverse of Sequence asList.
+func ListAsSequence(vm *VM, target, locals *Object, msg *Message) *Object {
+	kind := SeqF64
+	if msg.ArgCount() > 0 {
+		k, exc, stop := msg.StringArgAt(vm, locals, 0)
+		if stop != NoStop {
+			return vm.Stop(exc, stop)
+		}
+		var ok bool
+		kind, ok = SeqKindNamed(k)
+		if !ok {
+			return vm.RaiseExceptionf("invalid item type name %q", k)
+		}
+	}
+	target.Lock()
+	l := append([]*Object(nil), target.Value.([]*Object)...)
+	target.Unlock()
+	v := reflect.MakeSlice(kind.kind, len(l), len(l))
+	t := kind.kind.Elem()
+	for i, x := range l {
+		x.Lock()
+		n, ok := x.Value.(float64)
+		x.Unlock()
+		if !ok {
+			return vm.RaiseExceptionf("item %d of list must be Number, not %s", i, vm.TypeName(x))
+		}
+		v.Index(i).Set(reflect.ValueOf(n).Convert(t))
+	}
+	return vm.SequenceObject(Sequence{Value: v.Interface(), Mutable: true, Code: "number"})
+}
+
+// ListAsSortedList is a List method.
+//
+// asSortedList returns a new list of the items of the receiver sorted
+// according to their compare method, leaving the receiver unchanged. If a
+// message is given, items are compared by the results of sending it to them.
+// The sort is stable, so items which compare equal keep their original order.
+func ListAsSortedList(vm *VM, target, locals *Object, msg *Message) *Object {
+	target.Lock()
+	l := append([]*Object(nil), target.Value.([]*Object)...)
+	target.Unlock()
+	r := vm.NewList(l...)
+	ls := listSorter{
+		mu: &r.Mutex,
+		v:  l,
+		vm: vm,
+	}
+	if msg.ArgCount() > 0 {
+		ls.e = msg.ArgAt(0)
+		ls.l = locals
+	}
+	sort.Stable(&ls)
+	if ls.c != NoStop {
+		return vm.Stop(ls.err, ls.c)
+	}
+	return r
+}
+
 // ListAsString is a List method.
 //
 // asString creates a string representation of an object.
@@ -277,6 +431,59 @@ func ListAtPut(vm *VM, target, locals *Object, msg *Message) *Object {
 	return target
 }
 
+// ListBinarySearch is a List method.
+//
+// binarySearch returns the index of an item in the list equal to the argument,
+// or nil if there is none. The list must be sorted in ascending order according
+// to its items' compare methods; otherwise, the result is undefined.
+func ListBinarySearch(vm *VM, target, locals *Object, msg *Message) *Object {
+	r, stop := msg.EvalArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(r, stop)
+	}
+	k, found, obj, stop := listSearch(vm, target, r)
+	if stop != NoStop {
+		return vm.Stop(obj, stop)
+	}
+	if !found {
+		return vm.Nil
+	}
+	return vm.NewNumber(float64(k))
+}
+
+// listSearch finds the first index in the sorted list at which v could be
+// inserted while keeping the list sorted, and whether the item already at that
+// index is equal to v.
+func listSearch(vm *VM, list, v *Object) (k int, found bool, obj *Object, stop Stop) {
+	list.Lock()
+	l := list.Value.([]*Object)
+	list.Unlock()
+	lo, hi := 0, len(l)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		c, obj, stop := vm.Compare(l[m], v)
+		if stop != NoStop {
+			return 0, false, obj, stop
+		}
+		if obj != nil {
+			return 0, false, vm.NewExceptionf("compare must return Number, not %s", vm.TypeName(obj)), ExceptionStop
+		}
+		if c < 0 {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	if lo < len(l) {
+		c, obj, stop := vm.Compare(l[lo], v)
+		if stop != NoStop {
+			return 0, false, obj, stop
+		}
+		found = obj == nil && c == 0
+	}
+	return lo, found, nil, NoStop
+}
+
 // ListCapacity is a List method.
 //
 // capacity is the number of items for which the list has allocated space.
@@ -287,6 +494,33 @@ func ListCapacity(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.NewNumber(float64(cap(l)))
 }
 
+// ListChunk is a List method.
+//
+// chunk returns a new list of lists containing the items of the list in order,
+// n at a time. The last list may have fewer than n items. n must be positive.
+func ListChunk(vm *VM, target, locals *Object, msg *Message) *Object {
+	n, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	if n < 1 {
+		return vm.RaiseExceptionf("chunk size must be positive, not %v", n)
+	}
+	target.Lock()
+	l := append([]*Object(nil), target.Value.([]*Object)...)
+	target.Unlock()
+	var r []*Object
+	for len(l) > 0 {
+		k := len(l)
+		if n < float64(k) {
+			k = int(n)
+		}
+		r = append(r, vm.NewList(l[:k:k]...))
+		l = l[k:]
+	}
+	return vm.NewList(r...)
+}
+
 // ListCompare is a List method.
 //
 // compare returns -1 if the receiver is less than the argument, 1 if it is
@@ -465,6 +699,269 @@ func ListContainsIdenticalTo(vm *VM, target, locals *Object, msg *Message) *Obje
 	return vm.False
 }
 
+// ListCount is a List method.
+//
+// count returns the number of items in the list for which a condition is
+// true. The condition may be a message to send to each item, or it may be
+// preceded by the names of value and optionally index variables. With no
+// arguments, count is the size of the list.
+//
+//   io> list(1, 2, 3, 4) count(isEven)
+//   2
+//   io> list(1, 2, 3, 4) count(i, x, i + x > 3)
+//   2
+func ListCount(vm *VM, target, locals *Object, msg *Message) *Object {
+	target.Lock()
+	l := append([]*Object(nil), target.Value.([]*Object)...)
+	target.Unlock()
+	if msg.ArgCount() == 0 {
+		return vm.NewNumber(float64(len(l)))
+	}
+	kn, vn, hkn, hvn, ev := ForeachArgs(msg)
+	n := 0
+	for k, v := range l {
+		var r *Object
+		var control Stop
+		if hvn {
+			vm.SetSlot(locals, vn, v)
+			if hkn {
+				vm.SetSlot(locals, kn, vm.NewNumber(float64(k)))
+			}
+			r, control = ev.Eval(vm, locals)
+		} else {
+			r, control = ev.Send(vm, v, locals)
+		}
+		if control != NoStop {
+			return vm.Stop(r, control)
+		}
+		if vm.AsBool(r) {
+			n++
+		}
+	}
+	return vm.NewNumber(float64(n))
+}
+
+// ListDifference is a List method.
+//
+// difference returns a new list of the distinct items of the receiver which
+// are not equal to any item of the argument, in order of their first
+// occurrence. Items are compared with ==.
+func ListDifference(vm *VM, target, locals *Object, msg *Message) *Object {
+	return listSetOp(vm, target, locals, msg, false)
+}
+
+// listSet is a set of objects which uses == for membership. Numbers,
+// Sequences, and Lists are bucketed by HashObject so that most lookups need
+// only a few comparisons. Other objects may override ==, so they are kept in
+// a list which every lookup scans, and they receive == when compared with a
+// hashable object.
+type listSet struct {
+	buckets map[uint64][]*Object
+	other   []*Object
+	items   []*Object
+}
+
+// hashable returns whether obj's == is consistent with HashObject. Sequences
+// are hashable even across item types, since HashObject hashes only what
+// Sequence comparison preserves in either direction.
+func hashable(obj *Object) bool {
+	switch obj.Tag() {
+	case NumberTag, SequenceTag, ListTag:
+		return true
+	}
+	return false
+}
+
+// contains checks whether the set contains an item equal to x.
+func (set *listSet) contains(vm *VM, locals, x *Object) (bool, *Object, Stop) {
+	cands := set.items
+	if hashable(x) {
+		b := set.buckets[vm.HashObject(x)]
+		cands = append(b[:len(b):len(b)], set.other...)
+	}
+	for _, v := range cands {
+		// Send == to the object which might override it.
+		recv, arg := x, v
+		if !hashable(v) {
+			recv, arg = v, x
+		}
+		r, stop := vm.Perform(recv, locals, vm.IdentMessage("==", vm.CachedMessage(arg)))
+		if stop != NoStop {
+			return false, r, stop
+		}
+		if vm.AsBool(r) {
+			return true, nil, NoStop
+		}
+		if hashable(x) && hashable(v) {
+			// Sequence comparison converts the argument to the receiver's
+			// item type, so it can be true in only one direction. Check
+			// both so that set operations agree regardless of order.
+			r, stop = vm.Perform(arg, locals, vm.IdentMessage("==", vm.CachedMessage(recv)))
+			if stop != NoStop {
+				return false, r, stop
+			}
+			if vm.AsBool(r) {
+				return true, nil, NoStop
+			}
+		}
+	}
+	return false, nil, NoStop
+}
+
+// add adds x to the set if the set does not already contain it.
+func (set *listSet) add(vm *VM, locals, x *Object) (*Object, Stop) {
+	ok, r, stop := set.contains(vm, locals, x)
+	if stop != NoStop || ok {
+		return r, stop
+	}
+	if hashable(x) {
+		if set.buckets == nil {
+			set.buckets = make(map[uint64][]*Object)
+		}
+		h := vm.HashObject(x)
+		set.buckets[h] = append(set.buckets[h], x)
+	} else {
+		set.other = append(set.other, x)
+	}
+	set.items = append(set.items, x)
+	return nil, NoStop
+}
+
+// listSetOp implements difference and intersection. The result contains the
+// distinct items of the receiver which are in the argument if in is true or
+// which are not if in is false.
+func listSetOp(vm *VM, target, locals *Object, msg *Message, in bool) *Object {
+	other, obj, stop := msg.ListArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(obj, stop)
+	}
+	var set listSet
+	obj.Lock()
+	ol := append([]*Object(nil), other...)
+	obj.Unlock()
+	for _, v := range ol {
+		if r, stop := set.add(vm, locals, v); stop != NoStop {
+			return vm.Stop(r, stop)
+		}
+	}
+	target.Lock()
+	l := append([]*Object(nil), target.Value.([]*Object)...)
+	target.Unlock()
+	var res listSet
+	for _, v := range l {
+		ok, r, stop := set.contains(vm, locals, v)
+		if stop != NoStop {
+			return vm.Stop(r, stop)
+		}
+		if ok == in {
+			if r, stop := res.add(vm, locals, v); stop != NoStop {
+				return vm.Stop(r, stop)
+			}
+		}
+	}
+	return vm.NewList(res.items...)
+}
+
+// ListDrop is a List method.
+//
+// drop returns a new list containing all but the first n items of the list.
+// n is clamped to the size of the list.
+func ListDrop(vm *VM, target, locals *Object, msg *Message) *Object {
+	n, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	target.Lock()
+	l := target.Value.([]*Object)
+	k := clampCount(n, len(l))
+	r := append([]*Object(nil), l[k:]...)
+	target.Unlock()
+	return vm.NewList(r...)
+}
+
+// ListDropWhile is a List method.
+//
+// dropWhile evaluates a message for each item of the list in order,
+// optionally setting index and value variables, until a result is false, and
+// returns a new list containing the items from that point on.
+func ListDropWhile(vm *VM, target, locals *Object, msg *Message) *Object {
+	l, k, r, stop := listWhile(vm, target, locals, msg, "dropWhile")
+	if stop != NoStop {
+		return vm.Stop(r, stop)
+	}
+	return vm.NewList(l[k:]...)
+}
+
+// ListFill is a List method.
+//
+// fill sets every item of the list to the argument in place. The same object
+// is stored at each index; it is not cloned.
+func ListFill(vm *VM, target, locals *Object, msg *Message) *Object {
+	r, stop := msg.EvalArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(r, stop)
+	}
+	target.Lock()
+	l := target.Value.([]*Object)
+	for i := range l {
+		l[i] = r
+	}
+	target.Unlock()
+	return target
+}
+
+// ListFlatMap is a List method.
+//
+// flatMap evaluates a message for each item of the list, optionally setting
+// index and value variables, and returns a new list containing the items of
+// each result concatenated. A result that is not a List contributes itself as
+// a single item.
+func ListFlatMap(vm *VM, target, locals *Object, msg *Message) *Object {
+	kn, vn, hkn, hvn, ev := ForeachArgs(msg)
+	if ev == nil {
+		return vm.RaiseExceptionf("flatMap requires 1, 2, or 3 arguments")
+	}
+	var r []*Object
+	target.Lock()
+	l := target.Value.([]*Object)
+	for k := 0; k < len(l); k++ {
+		v := l[k]
+		target.Unlock()
+		var result *Object
+		var control Stop
+		if hvn {
+			vm.SetSlot(locals, vn, v)
+			if hkn {
+				vm.SetSlot(locals, kn, vm.NewNumber(float64(k)))
+			}
+			result, control = ev.Eval(vm, locals)
+		} else {
+			result, control = ev.Send(vm, v, locals)
+		}
+		switch control {
+		case NoStop:
+			result.Lock()
+			if x, ok := result.Value.([]*Object); ok {
+				r = append(r, x...)
+			} else {
+				r = append(r, result)
+			}
+			result.Unlock()
+		case ContinueStop: // do nothing
+		case BreakStop:
+			return vm.NewList(r...)
+		case ReturnStop, ExceptionStop, ExitStop:
+			return vm.Stop(result, control)
+		default:
+			panic(fmt.Sprintf("iolang: invalid Stop: %v", control))
+		}
+		target.Lock()
+		l = target.Value.([]*Object)
+	}
+	target.Unlock()
+	return vm.NewList(r...)
+}
+
 // ListForeach is a List method.
 //
 // foreach performs a loop on each item of a list in order, optionally setting
@@ -505,6 +1002,14 @@ func ListForeach(vm *VM, target, locals *Object, msg *Message) (result *Object)
 	return result
 }
 
+// ListHash is a List method.
+//
+// hash returns a hash of the list computed from the hashes of its items. Lists
+// of Numbers, Sequences, and such Lists which are equal have equal hashes.
+func ListHash(vm *VM, target, locals *Object, msg *Message) *Object {
+	return vm.hashNumber(vm.HashObject(target))
+}
+
 // ListIndexOf is a List method.
 //
 // indexOf returns the first index from the left of an item equal to the
@@ -533,6 +1038,82 @@ func ListIndexOf(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.Nil
 }
 
+// ListInsertionIndexOf is a List method.
+//
+// insertionIndexOf returns the first index at which the argument could be
+// inserted into the list while keeping it sorted. The list must be sorted in
+// ascending order according to its items' compare methods; otherwise, the
+// result is undefined.
+func ListInsertionIndexOf(vm *VM, target, locals *Object, msg *Message) *Object {
+	r, stop := msg.EvalArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(r, stop)
+	}
+	k, _, obj, stop := listSearch(vm, target, r)
+	if stop != NoStop {
+		return vm.Stop(obj, stop)
+	}
+	return vm.NewNumber(float64(k))
+}
+
+// ListIntersection is a List method.
+//
+// intersection returns a new list of the distinct items of the receiver which
+// are equal to some item of the argument, in order of their first occurrence.
+// Items are compared with ==.
+func ListIntersection(vm *VM, target, locals *Object, msg *Message) *Object {
+	return listSetOp(vm, target, locals, msg, true)
+}
+
+// ListPartitionBy is a List method.
+//
+// partitionBy evaluates a message for each item of the list, optionally
+// setting index and value variables, and returns a new list of lists grouping
+// consecutive runs of items for which the results are ==.
+func ListPartitionBy(vm *VM, target, locals *Object, msg *Message) *Object {
+	kn, vn, hkn, hvn, ev := ForeachArgs(msg)
+	if ev == nil {
+		return vm.RaiseExceptionf("partitionBy requires 1, 2, or 3 arguments")
+	}
+	target.Lock()
+	l := append([]*Object(nil), target.Value.([]*Object)...)
+	target.Unlock()
+	var r []*Object
+	var prev *Object
+	start := 0
+	for k, v := range l {
+		var key *Object
+		var control Stop
+		if hvn {
+			vm.SetSlot(locals, vn, v)
+			if hkn {
+				vm.SetSlot(locals, kn, vm.NewNumber(float64(k)))
+			}
+			key, control = ev.Eval(vm, locals)
+		} else {
+			key, control = ev.Send(vm, v, locals)
+		}
+		if control != NoStop {
+			return vm.Stop(key, control)
+		}
+		if k > 0 {
+			eq, control := vm.Perform(prev, locals, vm.IdentMessage("==", vm.CachedMessage(key)))
+			if control != NoStop {
+				return vm.Stop(eq, control)
+			}
+			if !vm.AsBool(eq) {
+				r = append(r, vm.NewList(l[start:k:k]...))
+				start = k
+			}
+		}
+		prev = key
+	}
+	if start < len(l) {
+		r = append(r, vm.NewList(l[start:]...))
+	}
+	return vm.NewList(r...)
+}
+
 // ListPreallocateToSize is a List method.
 //
 // preallocateToSize ensures that the list has capacity for at least n items.
@@ -585,6 +1166,20 @@ func ListPrepend(vm *VM, target, locals *Object, msg *Message) *Object {
 	return target
 }
 
+// ListRandomElement is a List method.
+//
+// randomElement returns an item of the list chosen uniformly at random, drawing
+// from the VM's global random source, or nil if the list is empty.
+func ListRandomElement(vm *VM, target, locals *Object, msg *Message) *Object {
+	target.Lock()
+	defer target.Unlock()
+	l := target.Value.([]*Object)
+	if len(l) == 0 {
+		return vm.Nil
+	}
+	return l[vm.rand.Intn(len(l))]
+}
+
 // ListRemove is a List method.
 //
 // remove removes all occurrences of each item from the list. The behavior of
@@ -715,6 +1310,70 @@ func ListReverseInPlace(vm *VM, target, locals *Object, msg *Message) *Object {
 	return target
 }
 
+// ListScan is a List method.
+//
+// scan returns a list of the successive accumulator values of a reduce over
+// the list. It accepts the same forms as reduce: scan(op) and scan(op, init)
+// send the message named op to the accumulator with each item as its
+// argument, while scan(acc, x, body) and scan(acc, x, body, init) evaluate
+// body with acc and x set to the accumulator and item. If an initial value is
+// given, it is the first element of the result, which has one more element
+// than the receiver; otherwise, the first item of the list is used, and the
+// result has the same size as the receiver.
+//
+//   io> list(1, 2, 3, 4) scan(+)
+//   list(1, 3, 6, 10)
+//   io> list(1, 2, 3) scan(acc, x, acc * x, 10)
+//   list(10, 10, 20, 60)
+func ListScan(vm *VM, target, locals *Object, msg *Message) *Object {
+	argc := msg.ArgCount()
+	if argc < 1 || argc > 4 {
+		return vm.RaiseExceptionf("List scan must be called with 1 to 4 arguments")
+	}
+	target.Lock()
+	l := append([]*Object(nil), target.Value.([]*Object)...)
+	target.Unlock()
+	var acc *Object
+	if argc == 2 || argc == 4 {
+		r, stop := msg.EvalArgAt(vm, locals, argc-1)
+		if stop != NoStop {
+			return vm.Stop(r, stop)
+		}
+		acc = r
+	} else {
+		if len(l) == 0 {
+			return vm.NewList()
+		}
+		acc, l = l[0], l[1:]
+	}
+	r := make([]*Object, 0, len(l)+1)
+	r = append(r, acc)
+	if argc <= 2 {
+		name := msg.ArgAt(0).Name()
+		for _, x := range l {
+			v, stop := vm.Perform(acc, locals, vm.IdentMessage(name, vm.CachedMessage(x)))
+			if stop != NoStop {
+				return vm.Stop(v, stop)
+			}
+			acc = v
+			r = append(r, acc)
+		}
+		return vm.NewList(r...)
+	}
+	an, xn, ev := msg.ArgAt(0).Name(), msg.ArgAt(1).Name(), msg.ArgAt(2)
+	for _, x := range l {
+		vm.SetSlot(locals, an, acc)
+		vm.SetSlot(locals, xn, x)
+		v, stop := ev.Eval(vm, locals)
+		if stop != NoStop {
+			return vm.Stop(v, stop)
+		}
+		acc = v
+		r = append(r, acc)
+	}
+	return vm.NewList(r...)
+}
+
 // ListSetSize is a List method.
 //
 // setSize changes the size of the list, removing items from or adding nils to
@@ -741,6 +1400,21 @@ func ListSetSize(vm *VM, target, locals *Object, msg *Message) *Object {
 	return target
 }
 
+// ListShuffle is a List method.
+//
+// shuffle randomly permutes the items of the list in place, drawing from the
+// VM's global random source.
+func ListShuffle(vm *VM, target, locals *Object, msg *Message) *Object {
+	target.Lock()
+	l := target.Value.([]*Object)
+	for i := len(l) - 1; i > 0; i-- {
+		j := vm.rand.Intn(i + 1)
+		l[i], l[j] = l[j], l[i]
+	}
+	target.Unlock()
+	return target
+}
+
 // ListSize is a List method.
 //
 // size is the number of items in the list.
@@ -901,6 +1575,82 @@ func ListSliceInPlace(vm *VM, target, locals *Object, msg *Message) *Object {
 	return target
 }
 
+// ListSortBy is a List method.
+//
+// sortBy returns a new list of the items of the receiver sorted stably by the
+// keys a block computes for them, leaving the receiver unchanged. The block is
+// evaluated once for each item, receiving it as its argument, and the keys are
+// ordered by their compare methods. If the block takes two arguments, it is
+// instead a comparison block as for sortInPlaceBy.
+//
+//   io> list("ccc", "a", "bb") sortBy(block(s, s size))
+//   list(a, bb, ccc)
+func ListSortBy(vm *VM, target, locals *Object, msg *Message) *Object {
+	r, stop := msg.EvalArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(r, stop)
+	}
+	if r.Tag() != BlockTag {
+		return vm.RaiseExceptionf("argument 0 to List sortBy must be Block, not %s", vm.TypeName(r))
+	}
+	target.Lock()
+	l := append([]*Object(nil), target.Value.([]*Object)...)
+	target.Unlock()
+	if len(r.Value.(*Block).ArgNames) == 2 {
+		m := vm.NewList(l...)
+		ls := listSorter{
+			mu: &m.Mutex,
+			v:  l,
+			vm: vm,
+			l:  locals,
+			b:  r,
+			m:  vm.IdentMessage("", vm.IdentMessage(""), vm.IdentMessage("")),
+		}
+		sort.Stable(&ls)
+		if ls.c != NoStop {
+			return vm.Stop(ls.err, ls.c)
+		}
+		return m
+	}
+	keys := make([]*Object, len(l))
+	for i, v := range l {
+		k := vm.ActivateBlock(r, locals, locals, locals, vm.IdentMessage("", vm.CachedMessage(v)))
+		if k, stop := vm.Status(k); stop != NoStop {
+			return vm.Stop(k, stop)
+		}
+		keys[i] = k
+	}
+	// Sort a permutation so that keys and values move together.
+	p := make([]int, len(l))
+	for i := range p {
+		p[i] = i
+	}
+	var err *Object
+	c := NoStop
+	sort.SliceStable(p, func(i, j int) bool {
+		if c != NoStop {
+			return false
+		}
+		r, obj, stop := vm.Compare(keys[p[i]], keys[p[j]])
+		if stop != NoStop {
+			err, c = obj, stop
+			return false
+		}
+		if obj == nil {
+			return r < 0
+		}
+		return vm.AsBool(obj)
+	})
+	if c != NoStop {
+		return vm.Stop(err, c)
+	}
+	v := make([]*Object, len(l))
+	for i, k := range p {
+		v[i] = l[k]
+	}
+	return vm.NewList(v...)
+}
+
 type listSorter struct {
 	mu  *sync.Mutex // list's mutex
 	v   []*Object   // values to sort
@@ -1042,6 +1792,105 @@ func ListSwapIndices(vm *VM, target, locals *Object, msg *Message) *Object {
 	return target
 }
 
+// ListTake is a List method.
+//
+// take returns a new list containing the first n items of the list. n is
+// clamped to the size of the list.
+func ListTake(vm *VM, target, locals *Object, msg *Message) *Object {
+	n, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	target.Lock()
+	l := target.Value.([]*Object)
+	k := clampCount(n, len(l))
+	r := append([]*Object(nil), l[:k]...)
+	target.Unlock()
+	return vm.NewList(r...)
+}
+
+// ListTakeWhile is a List method.
+//
+// takeWhile evaluates a message for each item of the list in order,
+// optionally setting index and value variables, until a result is false, and
+// returns a new list containing the items before that point.
+func ListTakeWhile(vm *VM, target, locals *Object, msg *Message) *Object {
+	l, k, r, stop := listWhile(vm, target, locals, msg, "takeWhile")
+	if stop != NoStop {
+		return vm.Stop(r, stop)
+	}
+	return vm.NewList(l[:k]...)
+}
+
+// clampCount converts n to an int in [0, size]. NaN is treated as zero.
+func clampCount(n float64, size int) int {
+	if !(n > 0) {
+		return 0
+	}
+	if n >= float64(size) {
+		return size
+	}
+	return int(n)
+}
+
+// listWhile evaluates the foreach-style predicate in msg for each item of a
+// copy of the list, stopping at the first false result. The result is the
+// copy and the number of items for which the predicate held. Any control flow
+// from the predicate is returned.
+func listWhile(vm *VM, target, locals *Object, msg *Message, name string) (l []*Object, k int, result *Object, control Stop) {
+	kn, vn, hkn, hvn, ev := ForeachArgs(msg)
+	if ev == nil {
+		return nil, 0, vm.NewExceptionf("%s requires 1, 2, or 3 arguments", name), ExceptionStop
+	}
+	target.Lock()
+	l = append([]*Object(nil), target.Value.([]*Object)...)
+	target.Unlock()
+	for k = 0; k < len(l); k++ {
+		v := l[k]
+		if hvn {
+			vm.SetSlot(locals, vn, v)
+			if hkn {
+				vm.SetSlot(locals, kn, vm.NewNumber(float64(k)))
+			}
+			result, control = ev.Eval(vm, locals)
+		} else {
+			result, control = ev.Send(vm, v, locals)
+		}
+		if control != NoStop {
+			return nil, 0, result, control
+		}
+		if !vm.AsBool(result) {
+			break
+		}
+	}
+	return l, k, nil, NoStop
+}
+
+// ListUnion is a List method.
+//
+// union returns a new list of the distinct items of the receiver followed by
+// those of the argument which are not equal to any previous item, in order of
+// their first occurrence. Items are compared with ==.
+func ListUnion(vm *VM, target, locals *Object, msg *Message) *Object {
+	other, obj, stop := msg.ListArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(obj, stop)
+	}
+	obj.Lock()
+	ol := append([]*Object(nil), other...)
+	obj.Unlock()
+	target.Lock()
+	l := append([]*Object(nil), target.Value.([]*Object)...)
+	target.Unlock()
+	var set listSet
+	for _, v := range append(l, ol...) {
+		if r, stop := set.add(vm, locals, v); stop != NoStop {
+			return vm.Stop(r, stop)
+		}
+	}
+	return vm.NewList(set.items...)
+}
+
 // ListWith is a List method.
 //
 // with creates a new list with the given values as items.
@@ -1056,3 +1905,32 @@ func ListWith(vm *VM, target, locals *Object, msg *Message) *Object {
 	}
 	return vm.NewList(v...)
 }
+
+// ListWithAll is a List method.
+//
+// withAll creates a new list containing the given number of copies of a
+// value. As with other Io assignments, every item is the same object; it is
+// not cloned, so mutating one item mutates them all. The count must be a
+// nonnegative integer.
+func ListWithAll(vm *VM, target, locals *Object, msg *Message) *Object {
+	n, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	k, err := countArg(n, "withAll count")
+	if err != nil {
+		return vm.IoError(err)
+	}
+	if n != math.Trunc(n) {
+		return vm.RaiseExceptionf("withAll count must be an integer, not %v", n)
+	}
+	r, stop := msg.EvalArgAt(vm, locals, 1)
+	if stop != NoStop {
+		return vm.Stop(r, stop)
+	}
+	v := make([]*Object, k)
+	for i := range v {
+		v[i] = r
+	}
+	return vm.NewList(v...)
+}
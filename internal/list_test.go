@@ -0,0 +1,258 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/zephyrtronium/iolang"
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+// TestListMethods tests List methods.
+func TestListMethods(t *testing.T) {
+	vm := testutils.VM()
+	list123 := vm.NewList(vm.NewNumber(1), vm.NewNumber(2), vm.NewNumber(3))
+	list321 := vm.NewList(vm.NewNumber(3), vm.NewNumber(2), vm.NewNumber(1))
+	cases := map[string]map[string]testutils.SourceTestCase{
+		"asMapWith": {
+			"last":      {Source: `Object clone do(r := list("a", "bb", "cc") asMapWith(s, s size) at("2")) r`, Pass: testutils.PassEqual(vm.NewString("cc"))},
+			"keys":      {Source: `Object clone do(r := list("a", "bb", "cc") asMapWith(s, s size) keys sort) r`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("1"), vm.NewString("2")))},
+			"collect":   {Source: `Object clone do(r := list("a", "bb", "cc") asMapWith(s, s size, true) at("2")) r`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("bb"), vm.NewString("cc")))},
+			"single":    {Source: `Object clone do(r := list("a", "bb", "cc") asMapWith(s, s size, true) at("1")) r`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a")))},
+			"empty":     {Source: `Object clone do(r := list asMapWith(s, s) size) r`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"args":      {Source: `Object clone do(r := list(1) asMapWith(s)) r`, Pass: testutils.PassFailure()},
+			"notString": {Source: `Object clone do(o := Object clone do(asString := 1); r := list(o) asMapWith(v, v)) r`, Pass: testutils.PassFailure()},
+			"exception": {Source: `Object clone do(r := list(1) asMapWith(v, Exception raise)) r`, Pass: testutils.PassFailure()},
+		},
+		"asSequence": {
+			"default":   {Source: `list(1, 2.5) asSequence itemType`, Pass: testutils.PassEqual(vm.NewString("float64"))},
+			"values":    {Source: `list(1, 2.5) asSequence asList`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(1), vm.NewNumber(2.5)))},
+			"itemType":  {Source: `list(1, 2) asSequence("int16") itemType`, Pass: testutils.PassEqual(vm.NewString("int16"))},
+			"encoding":  {Source: `list(1, 2) asSequence("uint8") encoding`, Pass: testutils.PassEqual(vm.NewString("number"))},
+			"mutable":   {Source: `list(1) asSequence isMutable`, Pass: testutils.PassIdentical(vm.True)},
+			"truncate":  {Source: `list(1.5, -2.5) asSequence("int32") at(1)`, Pass: testutils.PassEqual(vm.NewNumber(-2))},
+			"roundTrip": {Source: `list(1, 2.5) asSequence asList asSequence == list(1, 2.5) asSequence`, Pass: testutils.PassIdentical(vm.True)},
+			"empty":     {Source: `list asSequence size`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"notNum":    {Source: `list(1, "a") asSequence`, Pass: testutils.PassFailure()},
+			"badType":   {Source: `list(1) asSequence("nope")`, Pass: testutils.PassFailure()},
+			"notStr":    {Source: `list(1) asSequence(1)`, Pass: testutils.PassFailure()},
+		},
+		"asSortedList": {
+			"numbers":   {Source: `list(3, 1, 2) asSortedList`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(1), vm.NewNumber(2), vm.NewNumber(3)))},
+			"unchanged": {Source: `Object clone do(l := list(3, 1, 2); l asSortedList) l`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(3), vm.NewNumber(1), vm.NewNumber(2)))},
+			"copy":      {Source: `Object clone do(l := list(1); b := l asSortedList isIdenticalTo(l)) b`, Pass: testutils.PassIdentical(vm.False)},
+			"strings":   {Source: `list("b", "c", "a") asSortedList`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a"), vm.NewString("b"), vm.NewString("c")))},
+			"stable":    {Source: `list(list(1, "a"), list(0, "b"), list(1, "c"), list(0, "d")) asSortedList(first) map(last) join`, Pass: testutils.PassEqual(vm.NewString("bdac"))},
+			"empty":     {Source: `list asSortedList`, Pass: testutils.PassEqual(vm.NewList())},
+			"mixed":     {Source: `list(Object clone, 1, "a", nil) asSortedList size`, Pass: testutils.PassEqual(vm.NewNumber(4))},
+			"exception": {Source: `list(1, 2) asSortedList(Exception raise)`, Pass: testutils.PassFailure()},
+		},
+		"binarySearch": {
+			"present":   {Source: `list(1, 3, 5, 7, 9) binarySearch(5)`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"first":     {Source: `list(1, 3, 5, 7, 9) binarySearch(1)`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"last":      {Source: `list(1, 3, 5, 7, 9) binarySearch(9)`, Pass: testutils.PassEqual(vm.NewNumber(4))},
+			"absent":    {Source: `list(1, 3, 5, 7, 9) binarySearch(4)`, Pass: testutils.PassIdentical(vm.Nil)},
+			"below":     {Source: `list(1, 3, 5, 7, 9) binarySearch(0)`, Pass: testutils.PassIdentical(vm.Nil)},
+			"above":     {Source: `list(1, 3, 5, 7, 9) binarySearch(10)`, Pass: testutils.PassIdentical(vm.Nil)},
+			"empty":     {Source: `list binarySearch(1)`, Pass: testutils.PassIdentical(vm.Nil)},
+			"strings":   {Source: `list("a", "b", "c") binarySearch("c")`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"continue":  {Source: `list(1) binarySearch(continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+			"exception": {Source: `list(1) binarySearch(Exception raise)`, Pass: testutils.PassFailure()},
+		},
+		"chunk": {
+			"even":     {Source: `list(1, 2, 3, 4) chunk(2)`, Pass: testutils.PassEqual(vm.NewList(vm.NewList(vm.NewNumber(1), vm.NewNumber(2)), vm.NewList(vm.NewNumber(3), vm.NewNumber(4))))},
+			"short":    {Source: `list(1, 2, 3) chunk(2) last`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(3)))},
+			"large":    {Source: `list(1, 2, 3) chunk(5)`, Pass: testutils.PassEqual(vm.NewList(list123))},
+			"empty":    {Source: `list chunk(2)`, Pass: testutils.PassEqual(vm.NewList())},
+			"zero":     {Source: `list(1) chunk(0)`, Pass: testutils.PassFailure()},
+			"separate": {Source: `list(1, 2, 3, 4) chunk(2) do(first append(5)) at(1) size`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+		},
+		"count": {
+			"alwaysTrue":  {Source: `list(1, 2, 3) count(true)`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+			"alwaysFalse": {Source: `list(1, 2, 3) count(false)`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"empty":       {Source: `list count(true)`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"message":     {Source: `list(1, 2, 3, 4) count(isEven)`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"value":       {Source: `Object clone do(r := list(1, 2, 3, 4) count(x, x > 1)) r`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+			"index":       {Source: `Object clone do(r := list(1, 2, 3, 4) count(i, x, i + x > 3)) r`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"size":        {Source: `list(1, 2, 3) count`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+			"emptySize":   {Source: `list count`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"continue":    {Source: `list(1) count(continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+			"exception":   {Source: `list(1) count(Exception raise)`, Pass: testutils.PassFailure()},
+		},
+		"difference": {
+			"some":      {Source: `list(1, 4, 2, 5, 3) difference(list(4, 5))`, Pass: testutils.PassEqual(list123)},
+			"unique":    {Source: `list(1, 2, 1, 3, 2) difference(list)`, Pass: testutils.PassEqual(list123)},
+			"all":       {Source: `list(1, 2) difference(list(2, 1))`, Pass: testutils.PassEqual(vm.NewList())},
+			"empty":     {Source: `list difference(list(1))`, Pass: testutils.PassEqual(vm.NewList())},
+			"sequences": {Source: `list("a", "b") difference(list("a" asMutable))`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("b")))},
+			"itemTypes": {Source: `list(list(0) asSequence("uint8")) difference(list(list(256) asSequence("uint16"))) size`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"custom":    {Source: `Object clone do(r := list(1, 2, 3, 4) difference(list(Object clone do(== := method(x, x == 4))))) r`, Pass: testutils.PassEqual(list123)},
+			"notList":   {Source: `list(1) difference(1)`, Pass: testutils.PassFailure()},
+		},
+		"drop": {
+			"some":     {Source: `list(0, 1, 2, 3) drop(1)`, Pass: testutils.PassEqual(list123)},
+			"none":     {Source: `list(1, 2, 3) drop(0)`, Pass: testutils.PassEqual(list123)},
+			"negative": {Source: `list(1, 2, 3) drop(-1)`, Pass: testutils.PassEqual(list123)},
+			"all":      {Source: `list(1, 2, 3) drop(5)`, Pass: testutils.PassEqual(vm.NewList())},
+			"nan":      {Source: `list(1, 2, 3) drop(0 / 0)`, Pass: testutils.PassEqual(list123)},
+			"inf":      {Source: `list(1, 2, 3) drop(1 / 0)`, Pass: testutils.PassEqual(vm.NewList())},
+			"empty":    {Source: `list drop(1)`, Pass: testutils.PassEqual(vm.NewList())},
+			"copy":     {Source: `list(1, 2, 3) do(drop(0) append(4)) size`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+		},
+		"dropWhile": {
+			"some":      {Source: `list(0, 0, 1, 2, 3) dropWhile(== 0)`, Pass: testutils.PassEqual(list123)},
+			"never":     {Source: `list(1, 2, 3) dropWhile(== 0)`, Pass: testutils.PassEqual(list123)},
+			"always":    {Source: `list(1, 2, 3) dropWhile(true)`, Pass: testutils.PassEqual(vm.NewList())},
+			"empty":     {Source: `list dropWhile(true)`, Pass: testutils.PassEqual(vm.NewList())},
+			"value":     {Source: `Object clone do(r := list(0, 1, 2, 3) dropWhile(x, x < 1)) r`, Pass: testutils.PassEqual(list123)},
+			"index":     {Source: `Object clone do(r := list(9, 1, 2, 3) dropWhile(i, x, i < 1)) r`, Pass: testutils.PassEqual(list123)},
+			"continue":  {Source: `list(1) dropWhile(continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+			"exception": {Source: `list(1) dropWhile(Exception raise)`, Pass: testutils.PassFailure()},
+			"noArgs":    {Source: `list(1) dropWhile`, Pass: testutils.PassFailure()},
+		},
+		"fill": {
+			"all":      {Source: `list(1, 2, 3) fill(0)`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(0), vm.NewNumber(0), vm.NewNumber(0)))},
+			"empty":    {Source: `list fill(0)`, Pass: testutils.PassEqual(vm.NewList())},
+			"shared":   {Source: `list(1, 2) fill(list) do(at(0) append(1)) at(1) size`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"continue": {Source: `list(1) fill(continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+		},
+		"flatMap": {
+			"message":   {Source: `list(list(1), list(2, 3)) flatMap(reverse)`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(1), vm.NewNumber(3), vm.NewNumber(2)))},
+			"value":     {Source: `Object clone do(r := list(1, 2) flatMap(v, list(v, v * 10))) r`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(1), vm.NewNumber(10), vm.NewNumber(2), vm.NewNumber(20)))},
+			"index":     {Source: `Object clone do(r := list(5, 6) flatMap(i, v, list(i, v))) r`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(0), vm.NewNumber(5), vm.NewNumber(1), vm.NewNumber(6)))},
+			"single":    {Source: `Object clone do(r := list(1, 2) flatMap(v, v + 1)) r`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(2), vm.NewNumber(3)))},
+			"shallow":   {Source: `Object clone do(r := list(1) flatMap(v, list(list(v)))) r`, Pass: testutils.PassEqual(vm.NewList(vm.NewList(vm.NewNumber(1))))},
+			"emptyItem": {Source: `Object clone do(r := list(1, 2) flatMap(v, list)) r`, Pass: testutils.PassEqual(vm.NewList())},
+			"empty":     {Source: `list flatMap(v, list(v))`, Pass: testutils.PassEqual(vm.NewList())},
+			"break":     {Source: `Object clone do(r := list(1, 2, 3) flatMap(v, if(v == 2, break); list(v, v))) r`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(1), vm.NewNumber(1)))},
+			"continue":  {Source: `Object clone do(r := list(1, 2, 3) flatMap(v, if(v == 2, continue); list(v))) r`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(1), vm.NewNumber(3)))},
+			"noArgs":    {Source: `list(1) flatMap`, Pass: testutils.PassFailure()},
+			"exception": {Source: `list(1) flatMap(Exception raise)`, Pass: testutils.PassFailure()},
+		},
+		"intersection": {
+			"some":      {Source: `list(1, 4, 2, 5, 3) intersection(list(3, 2, 1, 0))`, Pass: testutils.PassEqual(list123)},
+			"unique":    {Source: `list(1, 2, 1, 3, 2) intersection(list(1, 2, 3))`, Pass: testutils.PassEqual(list123)},
+			"none":      {Source: `list(1, 2) intersection(list(3))`, Pass: testutils.PassEqual(vm.NewList())},
+			"empty":     {Source: `list(1, 2) intersection(list)`, Pass: testutils.PassEqual(vm.NewList())},
+			"lists":     {Source: `list(list(1), list(2)) intersection(list(list(2)))`, Pass: testutils.PassEqual(vm.NewList(vm.NewList(vm.NewNumber(2))))},
+			"itemTypes": {Source: `list(list(0) asSequence("uint8")) intersection(list(list(256) asSequence("uint16"))) size`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"notList":   {Source: `list(1) intersection(1)`, Pass: testutils.PassFailure()},
+		},
+		"insertionIndexOf": {
+			"present": {Source: `list(1, 3, 5, 7, 9) insertionIndexOf(5)`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"absent":  {Source: `list(1, 3, 5, 7, 9) insertionIndexOf(4)`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"first":   {Source: `list(1, 3, 5, 7, 9) insertionIndexOf(0)`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"last":    {Source: `list(1, 3, 5, 7, 9) insertionIndexOf(10)`, Pass: testutils.PassEqual(vm.NewNumber(5))},
+			"empty":   {Source: `list insertionIndexOf(1)`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+		},
+		"partitionBy": {
+			"runs":      {Source: `list(1, 3, 2, 4, 5) partitionBy(isOdd)`, Pass: testutils.PassEqual(vm.NewList(vm.NewList(vm.NewNumber(1), vm.NewNumber(3)), vm.NewList(vm.NewNumber(2), vm.NewNumber(4)), vm.NewList(vm.NewNumber(5))))},
+			"same":      {Source: `list(1, 2, 3) partitionBy(true)`, Pass: testutils.PassEqual(vm.NewList(list123))},
+			"empty":     {Source: `list partitionBy(true)`, Pass: testutils.PassEqual(vm.NewList())},
+			"value":     {Source: `Object clone do(r := list("a", "b", "cc") partitionBy(x, x size)) r size`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"continue":  {Source: `list(1) partitionBy(continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+			"exception": {Source: `list(1) partitionBy(Exception raise)`, Pass: testutils.PassFailure()},
+			"noArgs":    {Source: `list(1) partitionBy`, Pass: testutils.PassFailure()},
+		},
+		"reverse": {
+			"empty": {Source: `list reverse`, Pass: testutils.PassEqual(vm.NewList())},
+			"one":   {Source: `list(1) reverse`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(1)))},
+			"odd":   {Source: `list(1, 2, 3) reverse`, Pass: testutils.PassEqual(list321)},
+			"even":  {Source: `list(1, 2, 3, 4) reverse`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(4), vm.NewNumber(3), vm.NewNumber(2), vm.NewNumber(1)))},
+			"copy":  {Source: `list(1, 2, 3) do(reverse)`, Pass: testutils.PassEqual(list123)},
+		},
+		"randomElement": {
+			"empty":  {Source: `list randomElement`, Pass: testutils.PassIdentical(vm.Nil)},
+			"one":    {Source: `list(1) randomElement`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"member": {Source: `Object clone do(l := list(1, 2, 3); r := l contains(l randomElement)) r`, Pass: testutils.PassIdentical(vm.True)},
+		},
+		"reverseInPlace": {
+			"empty": {Source: `list reverseInPlace`, Pass: testutils.PassEqual(vm.NewList())},
+			"one":   {Source: `list(1) reverseInPlace`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(1)))},
+			"odd":   {Source: `list(1, 2, 3) reverseInPlace`, Pass: testutils.PassEqual(list321)},
+			"even":  {Source: `list(1, 2, 3, 4) reverseInPlace`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(4), vm.NewNumber(3), vm.NewNumber(2), vm.NewNumber(1)))},
+			"self":  {Source: `list(1, 2, 3) do(reverseInPlace)`, Pass: testutils.PassEqual(list321)},
+			"twice": {Source: `list(1, 2, 3) reverseInPlace reverseInPlace`, Pass: testutils.PassEqual(list123)},
+		},
+		"scan": {
+			"message":     {Source: `list(1, 2, 3, 4) scan(+)`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(1), vm.NewNumber(3), vm.NewNumber(6), vm.NewNumber(10)))},
+			"messageInit": {Source: `list(1, 2, 3) scan(+, 10)`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(10), vm.NewNumber(11), vm.NewNumber(13), vm.NewNumber(16)))},
+			"body":        {Source: `Object clone do(r := list(1, 2, 3) scan(acc, x, acc * 10 + x)) r`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(1), vm.NewNumber(12), vm.NewNumber(123)))},
+			"bodyInit":    {Source: `Object clone do(r := list(1, 2, 3) scan(acc, x, acc * x, 10)) r`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(10), vm.NewNumber(10), vm.NewNumber(20), vm.NewNumber(60)))},
+			"types":       {Source: `Object clone do(r := list("b", "c") scan(acc, x, acc .. x, "a")) r`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a"), vm.NewString("ab"), vm.NewString("abc")))},
+			"empty":       {Source: `list scan(+)`, Pass: testutils.PassEqual(vm.NewList())},
+			"emptyInit":   {Source: `list scan(+, 0)`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(0)))},
+			"noArgs":      {Source: `list(1) scan`, Pass: testutils.PassFailure()},
+			"continue":    {Source: `Object clone do(list(1, 2) scan(acc, x, continue))`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+			"exception":   {Source: `Object clone do(list(1, 2) scan(acc, x, Exception raise))`, Pass: testutils.PassFailure()},
+		},
+		"shuffle": {
+			"empty":       {Source: `list shuffle`, Pass: testutils.PassEqual(vm.NewList())},
+			"permutation": {Source: `list(3, 1, 2) shuffle sort`, Pass: testutils.PassEqual(list123)},
+			"self":        {Source: `Object clone do(l := list(1, 2, 3); r := l shuffle isIdenticalTo(l)) r`, Pass: testutils.PassIdentical(vm.True)},
+		},
+		"sortBy": {
+			"key":       {Source: `list("ccc", "a", "bb") sortBy(block(s, s size))`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a"), vm.NewString("bb"), vm.NewString("ccc")))},
+			"stable":    {Source: `list("b1", "a1", "b2", "a2") sortBy(block(s, s exSlice(0, 1))) join`, Pass: testutils.PassEqual(vm.NewString("a1a2b1b2"))},
+			"negate":    {Source: `list(1, 3, 2) sortBy(block(x, x negate))`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(3), vm.NewNumber(2), vm.NewNumber(1)))},
+			"unchanged": {Source: `Object clone do(l := list(3, 1, 2); l sortBy(block(x, x))) l`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(3), vm.NewNumber(1), vm.NewNumber(2)))},
+			"once":      {Source: `Object clone do(n := 0; list(3, 1, 2, 5, 4) sortBy(block(x, n = n + 1; x))) n`, Pass: testutils.PassEqual(vm.NewNumber(5))},
+			"compare":   {Source: `list(1, 3, 2) sortBy(block(x, y, x > y))`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(3), vm.NewNumber(2), vm.NewNumber(1)))},
+			"empty":     {Source: `list sortBy(block(x, x))`, Pass: testutils.PassEqual(vm.NewList())},
+			"notBlock":  {Source: `list(1) sortBy(1)`, Pass: testutils.PassFailure()},
+			"break":     {Source: `list(1, 2) sortBy(block(x, break) setPassStops(true))`, Pass: testutils.PassControl(vm.Nil, iolang.BreakStop)},
+			"return":    {Source: `list(1, 2) sortBy(block(x, return x) setPassStops(true))`, Pass: testutils.PassControl(vm.NewNumber(1), iolang.ReturnStop)},
+			"exception": {Source: `list(1, 2) sortBy(block(x, Exception raise))`, Pass: testutils.PassFailure()},
+		},
+		"take": {
+			"some":     {Source: `list(1, 2, 3, 4) take(3)`, Pass: testutils.PassEqual(list123)},
+			"none":     {Source: `list(1, 2, 3) take(0)`, Pass: testutils.PassEqual(vm.NewList())},
+			"negative": {Source: `list(1, 2, 3) take(-1)`, Pass: testutils.PassEqual(vm.NewList())},
+			"all":      {Source: `list(1, 2, 3) take(5)`, Pass: testutils.PassEqual(list123)},
+			"nan":      {Source: `list(1, 2, 3) take(0 / 0)`, Pass: testutils.PassEqual(vm.NewList())},
+			"inf":      {Source: `list(1, 2, 3) take(1 / 0)`, Pass: testutils.PassEqual(list123)},
+			"empty":    {Source: `list take(1)`, Pass: testutils.PassEqual(vm.NewList())},
+			"copy":     {Source: `list(1, 2, 3) do(take(3) append(4)) size`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+		},
+		"takeWhile": {
+			"some":      {Source: `list(1, 2, 3, 0, 4) takeWhile(> 0)`, Pass: testutils.PassEqual(list123)},
+			"never":     {Source: `list(1, 2, 3) takeWhile(false)`, Pass: testutils.PassEqual(vm.NewList())},
+			"always":    {Source: `list(1, 2, 3) takeWhile(true)`, Pass: testutils.PassEqual(list123)},
+			"empty":     {Source: `list takeWhile(true)`, Pass: testutils.PassEqual(vm.NewList())},
+			"value":     {Source: `Object clone do(r := list(1, 2, 3, 4) takeWhile(x, x < 4)) r`, Pass: testutils.PassEqual(list123)},
+			"index":     {Source: `Object clone do(r := list(1, 2, 3, 9) takeWhile(i, x, i < 3)) r`, Pass: testutils.PassEqual(list123)},
+			"continue":  {Source: `list(1) takeWhile(continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+			"exception": {Source: `list(1) takeWhile(Exception raise)`, Pass: testutils.PassFailure()},
+			"noArgs":    {Source: `list(1) takeWhile`, Pass: testutils.PassFailure()},
+		},
+		"union": {
+			"some":      {Source: `list(1, 2) union(list(2, 3))`, Pass: testutils.PassEqual(list123)},
+			"unique":    {Source: `list(1, 2, 1) union(list(3, 2, 3))`, Pass: testutils.PassEqual(list123)},
+			"empty":     {Source: `list union(list(1, 2, 3))`, Pass: testutils.PassEqual(list123)},
+			"sequences": {Source: `list("a") union(list("a" asMutable, "b"))`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a"), vm.NewString("b")))},
+			"itemTypes": {Source: `list(list(0) asSequence("uint8")) union(list(list(256) asSequence("uint16"))) size`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"uniqueSeq": {Source: `list(list(0) asSequence("uint8"), list(256) asSequence("uint16")) unique size`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"zeros":     {Source: `list(0) union(list(-0))`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(0)))},
+			"custom":    {Source: `Object clone do(r := list(Object clone do(== := method(x, x == 2))) union(list(2, 3)) size) r`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"exception": {Source: `list(Object clone do(== := method(x, Exception raise))) union(list(1))`, Pass: testutils.PassFailure()},
+			"notList":   {Source: `list(1) union(1)`, Pass: testutils.PassFailure()},
+		},
+		"withAll": {
+			"some":     {Source: `List withAll(3, 0)`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(0), vm.NewNumber(0), vm.NewNumber(0)))},
+			"zero":     {Source: `List withAll(0, 1)`, Pass: testutils.PassEqual(vm.NewList())},
+			"negative": {Source: `List withAll(-1, 1)`, Pass: testutils.PassFailure()},
+			"nan":      {Source: `List withAll(0 / 0, 1)`, Pass: testutils.PassFailure()},
+			"inf":      {Source: `List withAll(1 / 0, 1)`, Pass: testutils.PassFailure()},
+			"huge":     {Source: `List withAll(2 ** 62, 1)`, Pass: testutils.PassFailure()},
+			"fraction": {Source: `List withAll(1.5, 1)`, Pass: testutils.PassFailure()},
+			"shared":   {Source: `List withAll(2, list) do(at(0) append(1)) at(1) size`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"continue": {Source: `List withAll(1, continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			for name, s := range c {
+				t.Run(name, s.TestFunc("TestListMethods"))
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package internal
 
 import (
 	"fmt"
+	"sort"
 )
 
 // tagMap is the Tag type for Map objects.
@@ -45,6 +46,8 @@ func (vm *VM) initMap() {
 		"foreach":       vm.NewCFunction(MapForeach, MapTag),
 		"hasKey":        vm.NewCFunction(MapHasKey, MapTag),
 		"keys":          vm.NewCFunction(MapKeys, MapTag),
+		"merge":         vm.NewCFunction(MapMerge, MapTag),
+		"mergeInPlace":  vm.NewCFunction(MapMergeInPlace, MapTag),
 		"removeAt":      vm.NewCFunction(MapRemoveAt, MapTag),
 		"size":          vm.NewCFunction(MapSize, MapTag),
 		"type":          vm.NewString("Map"),
@@ -206,9 +209,102 @@ func MapKeys(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.NewList(l...)
 }
 
+// MapMerge is a Map method.
+//
+// merge returns a new Map containing the keys and values of the receiver and
+// the argument Map, with the argument's values replacing the receiver's where
+// both contain a key. If a block is given, it is instead called with the key,
+// the receiver's value, and the argument's value for each key in both maps,
+// and its result is used.
+//
+//   io> Map clone atPut("a", 1) merge(Map clone atPut("a", 2)) at("a")
+//   2
+//   io> Map clone atPut("a", 1) merge(Map clone atPut("a", 2), block(k, x, y, x + y)) at("a")
+//   3
+func MapMerge(vm *VM, target, locals *Object, msg *Message) *Object {
+	target.Lock()
+	m := tagMap{}.CloneValue(target.Value).(map[string]*Object)
+	target.Unlock()
+	r := vm.NewMap(m)
+	return mergeMap(vm, r, locals, msg)
+}
+
+// MapMergeInPlace is a Map method.
+//
+// mergeInPlace adds the keys and values of the argument Map to the receiver,
+// with the argument's values replacing the receiver's where both contain a
+// key. If a block is given, it is instead called with the key, the receiver's
+// value, and the argument's value for each key in both maps, and its result is
+// used.
+func MapMergeInPlace(vm *VM, target, locals *Object, msg *Message) *Object {
+	return mergeMap(vm, target, locals, msg)
+}
+
+// mergeMap merges the map argument of msg into target, returning target or
+// the result of a stop.
+func mergeMap(vm *VM, target, locals *Object, msg *Message) *Object {
+	other, stop := msg.EvalArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(other, stop)
+	}
+	if other.Tag() != MapTag {
+		return vm.RaiseExceptionf("argument 0 to %s must be Map, not %s", msg.Name(), vm.TypeName(other))
+	}
+	var blk *Object
+	if msg.ArgCount() > 1 {
+		blk, stop = msg.EvalArgAt(vm, locals, 1)
+		if stop != NoStop {
+			return vm.Stop(blk, stop)
+		}
+		if blk.Tag() != BlockTag {
+			return vm.RaiseExceptionf("argument 1 to %s must be Block, not %s", msg.Name(), vm.TypeName(blk))
+		}
+	}
+	type conflict struct {
+		key  string
+		x, y *Object
+	}
+	var conflicts []conflict
+	// Lock in a consistent order so that merging two maps into each other
+	// concurrently cannot deadlock.
+	a, b := target, other
+	if a.UniqueID() > b.UniqueID() {
+		a, b = b, a
+	}
+	a.Lock()
+	if a != b {
+		b.Lock()
+	}
+	dst, src := target.Value.(map[string]*Object), other.Value.(map[string]*Object)
+	for k, v := range src {
+		if x, ok := dst[k]; ok && blk != nil {
+			conflicts = append(conflicts, conflict{k, x, v})
+			continue
+		}
+		dst[k] = v
+	}
+	if a != b {
+		b.Unlock()
+	}
+	a.Unlock()
+	sort.Slice(conflicts, func(i, j int) bool { return conflicts[i].key < conflicts[j].key })
+	for _, c := range conflicts {
+		m := vm.IdentMessage("", vm.CachedMessage(vm.NewString(c.key)), vm.CachedMessage(c.x), vm.CachedMessage(c.y))
+		r := vm.ActivateBlock(blk, locals, locals, locals, m)
+		if r, stop := vm.Status(r); stop != NoStop {
+			return vm.Stop(r, stop)
+		}
+		target.Lock()
+		target.Value.(map[string]*Object)[c.key] = r
+		target.Unlock()
+	}
+	return target
+}
+
 // MapRemoveAt is a Map method.
 //
-// removeAt removes a key from the map if it exists.
+// removeAt removes a key from the map if it exists, returning whether it was
+// present.
 func MapRemoveAt(vm *VM, target, locals *Object, msg *Message) *Object {
 	key, exc, stop := msg.StringArgAt(vm, locals, 0)
 	if stop != NoStop {
@@ -216,9 +312,10 @@ func MapRemoveAt(vm *VM, target, locals *Object, msg *Message) *Object {
 	}
 	target.Lock()
 	m := target.Value.(map[string]*Object)
+	_, ok := m[key]
 	delete(m, key)
 	target.Unlock()
-	return target
+	return vm.IoBool(ok)
 }
 
 // MapSize is a Map method.
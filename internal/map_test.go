@@ -0,0 +1,60 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+// TestMapMethods tests Map methods.
+func TestMapMethods(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]map[string]testutils.SourceTestCase{
+		"size": {
+			"empty":   {Source: `Map clone size`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"full":    {Source: `Map clone atPut("a", 1) atPut("b", 2) size`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"replace": {Source: `Map clone atPut("a", 1) atPut("a", 2) size`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"removed": {Source: `Map clone atPut("a", 1) atPut("b", 2) do(removeAt("a")) size`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+		},
+		"isEmpty": {
+			"empty":   {Source: `Map clone isEmpty`, Pass: testutils.PassIdentical(vm.True)},
+			"full":    {Source: `Map clone atPut("a", 1) isEmpty`, Pass: testutils.PassIdentical(vm.False)},
+			"removed": {Source: `Map clone atPut("a", 1) do(removeAt("a")) isEmpty`, Pass: testutils.PassIdentical(vm.True)},
+		},
+		"removeAt": {
+			"present": {Source: `Map clone atPut("a", 1) removeAt("a")`, Pass: testutils.PassIdentical(vm.True)},
+			"missing": {Source: `Map clone removeAt("a")`, Pass: testutils.PassIdentical(vm.False)},
+			"twice":   {Source: `Map clone atPut("a", 1) do(removeAt("a")) removeAt("a")`, Pass: testutils.PassIdentical(vm.False)},
+			"gone":    {Source: `Map clone atPut("a", 1) do(removeAt("a")) hasKey("a")`, Pass: testutils.PassIdentical(vm.False)},
+			"bad":     {Source: `Map clone removeAt(1)`, Pass: testutils.PassFailure()},
+		},
+		"hasKey": {
+			"present": {Source: `Map clone atPut("a", 1) hasKey("a")`, Pass: testutils.PassIdentical(vm.True)},
+			"missing": {Source: `Map clone atPut("a", 1) hasKey("b")`, Pass: testutils.PassIdentical(vm.False)},
+			"nil":     {Source: `Map clone atPut("a", nil) hasKey("a")`, Pass: testutils.PassIdentical(vm.True)},
+		},
+		"merge": {
+			"union":     {Source: `Map clone atPut("a", 1) merge(Map clone atPut("b", 2)) keys sort`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a"), vm.NewString("b")))},
+			"wins":      {Source: `Map clone atPut("a", 1) merge(Map clone atPut("a", 2)) at("a")`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"unchanged": {Source: `Object clone do(m := Map clone atPut("a", 1); m merge(Map clone atPut("b", 2))) m size`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"block":     {Source: `Map clone atPut("a", 1) atPut("b", 1) merge(Map clone atPut("a", 2), block(k, x, y, k .. x .. y)) at("a")`, Pass: testutils.PassEqual(vm.NewString("a12"))},
+			"blockOnly": {Source: `Map clone atPut("a", 1) merge(Map clone atPut("b", 2), block(k, x, y, nil)) at("b")`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"bad":       {Source: `Map clone merge(1)`, Pass: testutils.PassFailure()},
+			"badBlock":  {Source: `Map clone merge(Map clone, 1)`, Pass: testutils.PassFailure()},
+			"exception": {Source: `Map clone atPut("a", 1) merge(Map clone atPut("a", 2), block(k, x, y, Exception raise))`, Pass: testutils.PassFailure()},
+		},
+		"mergeInPlace": {
+			"receiver": {Source: `Object clone do(m := Map clone atPut("a", 1); m mergeInPlace(Map clone atPut("a", 2) atPut("b", 3)); r := m at("a") * m at("b")) r`, Pass: testutils.PassEqual(vm.NewNumber(6))},
+			"self":     {Source: `Object clone do(m := Map clone atPut("a", 1); m mergeInPlace(m)) m size`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"block":    {Source: `Map clone atPut("a", 1) mergeInPlace(Map clone atPut("a", 2), block(k, x, y, x + y)) at("a")`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+			"returns":  {Source: `Object clone do(m := Map clone; r := m mergeInPlace(Map clone) isIdenticalTo(m)) r`, Pass: testutils.PassIdentical(vm.True)},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			for name, s := range c {
+				t.Run(name, s.TestFunc("TestMapMethods"))
+			}
+		})
+	}
+}
@@ -209,6 +209,9 @@ func (m *Message) Send(vm *VM, target, locals *Object) (result *Object, control
 		} else if !m.IsTerminator() {
 			result, control = vm.Perform(target, locals, m)
 			if control != NoStop {
+				if control == ExceptionStop {
+					addStackFrame(result, m)
+				}
 				return result, control
 			}
 			target = result
@@ -224,6 +227,19 @@ func (m *Message) Send(vm *VM, target, locals *Object) (result *Object, control
 	return result, NoStop
 }
 
+// addStackFrame records msg in the stack of exc if it is an Exception.
+func addStackFrame(exc *Object, msg *Message) {
+	if exc == nil || exc.Tag() != ExceptionTag {
+		return
+	}
+	exc.Lock()
+	if e, ok := exc.Value.(Exception); ok {
+		e.Stack = append(e.Stack, msg)
+		exc.Value = e
+	}
+	exc.Unlock()
+}
+
 // Perform executes a single message and checks for control flow signals. Any
 // received control flow except NoStop and ResumeStop overrides the perform
 // result.
@@ -231,6 +247,7 @@ func (m *Message) Send(vm *VM, target, locals *Object) (result *Object, control
 // NOTE: It is unsafe to call this while holding the lock of any object.
 func (vm *VM) Perform(target, locals *Object, msg *Message) (result *Object, control Stop) {
 	vm.DebugMessage(target, locals, msg)
+	vm.recordSend(target, msg)
 	var v, proto *Object
 	if v, proto = vm.GetSlot(target, msg.Text); proto == nil {
 		var forward, fp *Object
@@ -248,18 +265,7 @@ func (vm *VM) Perform(target, locals *Object, msg *Message) (result *Object, con
 	}
 	select {
 	case stop := <-vm.Control:
-		switch stop.Control {
-		case NoStop, ResumeStop:
-			// Yield.
-			runtime.Gosched()
-		case ContinueStop, BreakStop, ReturnStop, ExceptionStop, ExitStop:
-			// Return the stop.
-			return stop.Result, stop.Control
-		case PauseStop:
-			result, control = vm.doPause(result)
-		default:
-			panic(fmt.Sprintf("invalid status in received stop %#v", stop))
-		}
+		return vm.HandleRemoteStop(stop, result)
 	default: // No waiting stop; continue as normal.
 	}
 	return result, control
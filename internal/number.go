@@ -4,6 +4,8 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math"
+	"math/big"
+	"math/bits"
 	"strconv"
 	"strings"
 	"unicode"
@@ -19,11 +21,12 @@ const (
 	numberCacheMax = 256
 )
 
-// NewNumber creates a Number object with a given value.
+// NewNumber creates a Number object with a given value. Negative zero is never
+// cached, so that it keeps its sign.
 func (vm *VM) NewNumber(value float64) *Object {
 	if numberCacheMin <= value && value <= numberCacheMax {
 		x := int(value)
-		if float64(x) == value {
+		if float64(x) == value && (x != 0 || !math.Signbit(value)) {
 			return vm.numberCache[x-numberCacheMin]
 		}
 	}
@@ -63,9 +66,15 @@ func (vm *VM) initNumber() {
 		"acos":               vm.NewCFunction(NumberAcos, NumberTag),
 		"asBuffer":           vm.NewCFunction(NumberAsBuffer, NumberTag),
 		"asCharacter":        vm.NewCFunction(NumberAsCharacter, NumberTag),
+		"asFloat32Bits":      vm.NewCFunction(NumberAsFloat32Bits, NumberTag),
+		"asFloatBits":        vm.NewCFunction(NumberAsFloatBits, NumberTag),
+		"asGroupedString":    vm.NewCFunction(NumberAsGroupedString, NumberTag),
+		"asInteger":          vm.NewCFunction(NumberAsInteger, NumberTag),
 		"asLowercase":        vm.NewCFunction(NumberAsLowercase, NumberTag),
 		"asNumber":           vm.NewCFunction(ObjectThisContext, NumberTag), // hax
+		"asPaddedString":     vm.NewCFunction(NumberAsPaddedString, NumberTag),
 		"asString":           vm.NewCFunction(NumberAsString, NumberTag),
+		"asStringInBase":     vm.NewCFunction(NumberAsStringInBase, NumberTag),
 		"asUint32Buffer":     vm.NewCFunction(NumberAsUint32Buffer, NumberTag),
 		"asUppercase":        vm.NewCFunction(NumberAsUppercase, NumberTag),
 		"asin":               vm.NewCFunction(NumberAsin, NumberTag),
@@ -78,13 +87,24 @@ func (vm *VM) initNumber() {
 		"bitwiseOr":          vm.NewCFunction(NumberBitwiseOr, NumberTag),
 		"bitwiseXor":         vm.NewCFunction(NumberBitwiseXor, NumberTag),
 		"ceil":               vm.NewCFunction(NumberCeil, NumberTag),
+		"ceilToMultipleOf":   vm.NewCFunction(NumberCeilToMultipleOf, NumberTag),
+		"clamp":              vm.NewCFunction(NumberClamp, NumberTag),
 		"clip":               vm.NewCFunction(NumberClip, NumberTag),
 		"compare":            vm.NewCFunction(NumberCompare, NumberTag),
 		"cos":                vm.NewCFunction(NumberCos, NumberTag),
 		"cubed":              vm.NewCFunction(NumberCubed, NumberTag),
+		"digitsInBase":       vm.NewCFunction(NumberDigitsInBase, NumberTag),
+		"evalPolynomial":     vm.NewCFunction(NumberEvalPolynomial, NumberTag),
 		"exp":                vm.NewCFunction(NumberExp, NumberTag),
 		"factorial":          vm.NewCFunction(NumberFactorial, NumberTag),
 		"floor":              vm.NewCFunction(NumberFloor, NumberTag),
+		"floorMod":           vm.NewCFunction(NumberFloorMod, NumberTag),
+		"floorToMultipleOf":  vm.NewCFunction(NumberFloorToMultipleOf, NumberTag),
+		"fromDigits":         vm.NewCFunction(NumberFromDigits, nil),
+		"fromFloat32Bits":    vm.NewCFunction(NumberFromFloat32Bits, nil),
+		"fromFloatBits":      vm.NewCFunction(NumberFromFloatBits, nil),
+		"gcd":                vm.NewCFunction(NumberGCD, NumberTag),
+		"hash":               vm.NewCFunction(NumberHash, NumberTag),
 		"isAlphaNumeric":     vm.NewCFunction(NumberIsAlphaNumeric, NumberTag),
 		"isControlCharacter": vm.NewCFunction(NumberIsControlCharacter, NumberTag),
 		"isDigit":            vm.NewCFunction(NumberIsDigit, NumberTag),
@@ -94,10 +114,12 @@ func (vm *VM) initNumber() {
 		"isLowercase":        vm.NewCFunction(NumberIsLowercase, NumberTag),
 		"isNan":              vm.NewCFunction(NumberIsNan, NumberTag),
 		"isOdd":              vm.NewCFunction(NumberIsOdd, NumberTag),
+		"isPrime":            vm.NewCFunction(NumberIsPrime, NumberTag),
 		"isPrint":            vm.NewCFunction(NumberIsPrint, NumberTag),
 		"isPunctuation":      vm.NewCFunction(NumberIsPunctuation, NumberTag),
 		"isSpace":            vm.NewCFunction(NumberIsSpace, NumberTag),
 		"isUppercase":        vm.NewCFunction(NumberIsUppercase, NumberTag),
+		"lcm":                vm.NewCFunction(NumberLCM, NumberTag),
 		"log":                vm.NewCFunction(NumberLog, NumberTag),
 		"log10":              vm.NewCFunction(NumberLog10, NumberTag),
 		"log2":               vm.NewCFunction(NumberLog2, NumberTag),
@@ -105,10 +127,12 @@ func (vm *VM) initNumber() {
 		"min":                vm.NewCFunction(NumberMin, NumberTag),
 		"mod":                vm.NewCFunction(NumberMod, NumberTag),
 		"negate":             vm.NewCFunction(NumberNegate, NumberTag),
+		"nextPrime":          vm.NewCFunction(NumberNextPrime, NumberTag),
 		"pow":                vm.NewCFunction(NumberPow, NumberTag),
 		"repeat":             vm.NewCFunction(NumberRepeat, NumberTag),
 		"round":              vm.NewCFunction(NumberRound, NumberTag),
 		"roundDown":          vm.NewCFunction(NumberRoundDown, NumberTag),
+		"roundToMultipleOf":  vm.NewCFunction(NumberRoundToMultipleOf, NumberTag),
 		"shiftLeft":          vm.NewCFunction(NumberShiftLeft, NumberTag),
 		"shiftRight":         vm.NewCFunction(NumberShiftRight, NumberTag),
 		"sin":                vm.NewCFunction(NumberSin, NumberTag),
@@ -237,6 +261,131 @@ func NumberAsCharacter(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.NewString(string(rune(target.Value.(float64))))
 }
 
+// NumberAsFloat32Bits is a Number method.
+//
+// asFloat32Bits returns the IEEE-754 binary32 bit pattern of the target,
+// rounded to single precision, as an integer.
+func NumberAsFloat32Bits(vm *VM, target, locals *Object, msg *Message) *Object {
+	return vm.NewNumber(float64(math.Float32bits(float32(target.Value.(float64)))))
+}
+
+// NumberAsFloatBits is a Number method.
+//
+// asFloatBits returns the IEEE-754 binary64 bit pattern of the target as an
+// immutable Sequence holding a single uint64 item. Since Numbers are
+// themselves binary64, most bit patterns are not exactly representable as
+// Numbers; the Sequence holds them exactly.
+func NumberAsFloatBits(vm *VM, target, locals *Object, msg *Message) *Object {
+	return vm.NewSequence([]uint64{math.Float64bits(target.Value.(float64))}, false, "number")
+}
+
+// NumberAsGroupedString is a Number method.
+//
+// asGroupedString returns the decimal representation of the target with a
+// separator between each group of three digits of the integer part. The
+// optional arguments are the group separator, defaulting to ",", the decimal
+// separator, defaulting to ".", and the number of digits after the decimal
+// separator, defaulting to as many as needed to represent the number exactly.
+//
+//   io> 1234567.89 asGroupedString
+//   1,234,567.89
+//   io> (-1234.5) asGroupedString(".", ",", 2)
+//   -1.234,50
+func NumberAsGroupedString(vm *VM, target, locals *Object, msg *Message) *Object {
+	group, dec, prec := ",", ".", -1
+	if msg.ArgCount() > 0 {
+		s, exc, stop := msg.StringArgAt(vm, locals, 0)
+		if stop != NoStop {
+			return vm.Stop(exc, stop)
+		}
+		group = s
+	}
+	if msg.ArgCount() > 1 {
+		s, exc, stop := msg.StringArgAt(vm, locals, 1)
+		if stop != NoStop {
+			return vm.Stop(exc, stop)
+		}
+		dec = s
+	}
+	if msg.ArgCount() > 2 {
+		n, exc, stop := msg.NumberArgAt(vm, locals, 2)
+		if stop != NoStop {
+			return vm.Stop(exc, stop)
+		}
+		if n < 0 {
+			return vm.RaiseExceptionf("precision must not be negative, not %v", n)
+		}
+		prec = int(n)
+	}
+	x := target.Value.(float64)
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return vm.RaiseExceptionf("%v has no decimal representation", x)
+	}
+	d := strconv.FormatFloat(math.Abs(x), 'f', prec, 64)
+	ip, fp := d, ""
+	if k := strings.IndexByte(d, '.'); k >= 0 {
+		ip, fp = d[:k], d[k+1:]
+	}
+	var b strings.Builder
+	// Don't write a sign if the number rounds to zero.
+	if x < 0 && strings.Trim(d, "0.") != "" {
+		b.WriteByte('-')
+	}
+	for i := range ip {
+		if i > 0 && (len(ip)-i)%3 == 0 {
+			b.WriteString(group)
+		}
+		b.WriteByte(ip[i])
+	}
+	if fp != "" {
+		b.WriteString(dec)
+		b.WriteString(fp)
+	}
+	return vm.NewString(b.String())
+}
+
+// NumberAsInteger is a Number method.
+//
+// asInteger returns the target converted to an integral value using the given
+// rounding mode, which may be "trunc", "floor", "ceil", or "round". The
+// default is "trunc". It is an error to convert NaN or an infinity.
+func NumberAsInteger(vm *VM, target, locals *Object, msg *Message) *Object {
+	mode := "trunc"
+	if msg.ArgCount() > 0 {
+		s, exc, stop := msg.StringArgAt(vm, locals, 0)
+		if stop != NoStop {
+			return vm.Stop(exc, stop)
+		}
+		mode = s
+	}
+	x, err := AsInteger(target.Value.(float64), mode)
+	if err != nil {
+		return vm.IoError(err)
+	}
+	return vm.NewNumber(x)
+}
+
+// AsInteger converts x to an integral value using the named rounding mode,
+// which may be "trunc", "floor", "ceil", or "round". "round" rounds halfway
+// cases away from zero. The result is an error if x is NaN or infinite or the
+// mode is unrecognized.
+func AsInteger(x float64, mode string) (float64, error) {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return 0, fmt.Errorf("%v has no integer representation", x)
+	}
+	switch mode {
+	case "trunc":
+		return math.Trunc(x), nil
+	case "floor":
+		return math.Floor(x), nil
+	case "ceil":
+		return math.Ceil(x), nil
+	case "round":
+		return math.Round(x), nil
+	}
+	return 0, fmt.Errorf("unknown rounding mode %q", mode)
+}
+
 // NumberAsLowercase is a Number method.
 //
 // asLowercase returns the Number which is the Unicode codepoint corresponding
@@ -245,6 +394,37 @@ func NumberAsLowercase(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.NewNumber(float64(unicode.ToLower(rune(target.Value.(float64)))))
 }
 
+// NumberAsPaddedString is a Number method.
+//
+// asPaddedString returns the decimal representation of the target, truncated
+// to an integer, with zeros added on the left so that it has at least the
+// given number of digits. The sign of a negative number precedes the padding,
+// so -7 asPaddedString(3) is "-007".
+func NumberAsPaddedString(vm *VM, target, locals *Object, msg *Message) *Object {
+	n, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	if math.IsNaN(n) || math.IsInf(n, 0) || n > math.MaxInt32 {
+		return vm.RaiseExceptionf("invalid asPaddedString width %v", n)
+	}
+	x := target.Value.(float64)
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return vm.RaiseExceptionf("%v has no integer representation", x)
+	}
+	x = math.Trunc(x)
+	d := strconv.FormatFloat(math.Abs(x), 'f', 0, 64)
+	var b strings.Builder
+	if x < 0 {
+		b.WriteByte('-')
+	}
+	for i := len(d); i < int(n); i++ {
+		b.WriteByte('0')
+	}
+	b.WriteString(d)
+	return vm.NewString(b.String())
+}
+
 // NumberAsString is a Number method.
 //
 // asString returns the decimal string representation of the target.
@@ -252,6 +432,94 @@ func NumberAsString(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.NewString(strconv.FormatFloat(target.Value.(float64), 'g', -1, 64))
 }
 
+// NumberAsStringInBase is a Number method.
+//
+// asStringInBase returns the string representation of the target in the given
+// radix, including its fractional part, so 0.5 asStringInBase(2) is "0.1". The
+// optional second argument is the maximum number of digits after the radix
+// point, defaulting to enough to represent a float64; further digits are
+// truncated, and trailing zeros are trimmed. Bases less than 2 and greater
+// than 36 are not supported.
+//
+// Given three arguments, the second and third are instead a group size and a
+// separator to insert between each group of that many digits of the integer
+// part, counting from the radix point. Given four, they are the fraction
+// digits, group size, and separator.
+//
+//   io> 255 asStringInBase(16)
+//   ff
+//   io> 65535 asStringInBase(16, 2, ":")
+//   ff:ff
+//   io> 1023.5 asStringInBase(2, 1, 4, "_")
+//   11_1111_1111.1
+func NumberAsStringInBase(vm *VM, target, locals *Object, msg *Message) *Object {
+	arg, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	base := int(arg)
+	if base < 2 || base > 36 {
+		return vm.RaiseExceptionf("conversion to base %d not supported", base)
+	}
+	digits := int(math.Ceil(53 / math.Log2(float64(base))))
+	group, sep := 0, ""
+	if msg.ArgCount() > 2 {
+		k := msg.ArgCount() - 2
+		n, exc, stop := msg.NumberArgAt(vm, locals, k)
+		if stop != NoStop {
+			return vm.Stop(exc, stop)
+		}
+		if n < 1 {
+			return vm.RaiseExceptionf("group size must be positive, not %v", n)
+		}
+		group = int(n)
+		sep, exc, stop = msg.StringArgAt(vm, locals, k+1)
+		if stop != NoStop {
+			return vm.Stop(exc, stop)
+		}
+	}
+	if msg.ArgCount() == 2 || msg.ArgCount() > 3 {
+		n, exc, stop := msg.NumberArgAt(vm, locals, 1)
+		if stop != NoStop {
+			return vm.Stop(exc, stop)
+		}
+		if n < 0 {
+			return vm.RaiseExceptionf("fraction digits must not be negative, not %v", n)
+		}
+		digits = int(n)
+	}
+	x := target.Value.(float64)
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return vm.RaiseExceptionf("cannot convert %v to base %d", x, base)
+	}
+	var b strings.Builder
+	if x < 0 {
+		b.WriteByte('-')
+		x = -x
+	}
+	ip, fp := math.Modf(x)
+	i, _ := big.NewFloat(ip).Int(nil)
+	t := i.Text(base)
+	for k := range t {
+		if group > 0 && k > 0 && (len(t)-k)%group == 0 {
+			b.WriteString(sep)
+		}
+		b.WriteByte(t[k])
+	}
+	var frac []byte
+	for k := 0; k < digits && fp > 0; k++ {
+		var d float64
+		d, fp = math.Modf(fp * float64(base))
+		frac = append(frac, strconv.FormatInt(int64(d), base)[0])
+	}
+	frac = []byte(strings.TrimRight(string(frac), "0"))
+	if len(frac) > 0 {
+		b.WriteByte('.')
+		b.Write(frac)
+	}
+	return vm.NewString(b.String())
+}
+
 // NumberAsUint32Buffer is a Number method.
 //
 // asUint32Buffer returns a 4-byte buffer representing the target's value
@@ -382,6 +650,45 @@ func NumberCeil(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.NewNumber(math.Ceil(target.Value.(float64)))
 }
 
+// NumberCeilToMultipleOf is a Number method.
+//
+// ceilToMultipleOf returns the least multiple of the argument which is not less
+// than the target. The argument must be positive.
+func NumberCeilToMultipleOf(vm *VM, target, locals *Object, msg *Message) *Object {
+	return toMultipleOf(vm, target, locals, msg, math.Ceil)
+}
+
+// NumberClamp is a Number method.
+//
+// clamp returns the first argument if the receiver is less than it, the second
+// argument if the receiver is greater than it, or else the receiver. Unlike
+// clip, it raises an exception if the lower bound is greater than the upper or
+// if either bound is NaN. A NaN receiver produces NaN.
+//
+//   io> 12 clamp(0, 10)
+//   10
+func NumberClamp(vm *VM, target, locals *Object, msg *Message) *Object {
+	lo, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	hi, exc, stop := msg.NumberArgAt(vm, locals, 1)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	if !(lo <= hi) {
+		return vm.RaiseExceptionf("clamp requires lo <= hi, got %v and %v", lo, hi)
+	}
+	v := target.Value.(float64)
+	switch {
+	case v < lo:
+		v = lo
+	case v > hi:
+		v = hi
+	}
+	return vm.NewNumber(v)
+}
+
 // NumberClip is a Number method.
 //
 // clip returns the target if it is between the given bounds or else the
@@ -443,6 +750,40 @@ func NumberCubed(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.NewNumber(x * x * x)
 }
 
+// NumberDigitsInBase is a Number method.
+//
+// digitsInBase returns a List of the digits of the target, truncated to an
+// integer, in the given radix, most significant first. Zero has the single
+// digit 0. Radices less than 2 and greater than 36 are not supported, and
+// numbers which are negative, non-finite, or at least 2^64 raise an exception.
+func NumberDigitsInBase(vm *VM, target, locals *Object, msg *Message) *Object {
+	arg, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	if !(arg >= 2 && arg < 37) {
+		return vm.RaiseExceptionf("conversion to base %v not supported", arg)
+	}
+	base := int64(arg)
+	x := target.Value.(float64)
+	if !(x >= 0 && x < 1<<64) {
+		return vm.RaiseExceptionf("can't get digits of %v", x)
+	}
+	n := uint64(x)
+	var d []*Object
+	for {
+		d = append(d, vm.NewNumber(float64(n%uint64(base))))
+		n /= uint64(base)
+		if n == 0 {
+			break
+		}
+	}
+	for i, j := 0, len(d)-1; i < j; i, j = i+1, j-1 {
+		d[i], d[j] = d[j], d[i]
+	}
+	return vm.NewList(d...)
+}
+
 // NumberDiv is a Number method.
 //
 // / is an operator which divides the left value by the right.
@@ -454,6 +795,52 @@ func NumberDiv(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.NewNumber(target.Value.(float64) / arg)
 }
 
+// NumberEvalPolynomial is a Number method.
+//
+// evalPolynomial evaluates the polynomial with coefficients given by the
+// numeric sequence argument at the receiver using Horner's method. By default,
+// the coefficients are in ascending order of degree, so the first is the
+// constant term; if the optional second argument is true, they are in
+// descending order. An empty sequence gives 0.
+//
+//   io> 2 evalPolynomial(list(1, 0, 3) asSequence)
+//   13
+//   io> 2 evalPolynomial(list(1, 0, 3) asSequence, true)
+//   7
+func NumberEvalPolynomial(vm *VM, target, locals *Object, msg *Message) *Object {
+	c, obj, stop := msg.SequenceArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(obj, stop)
+	}
+	desc := false
+	if msg.ArgCount() > 1 {
+		r, stop := msg.EvalArgAt(vm, locals, 1)
+		if stop != NoStop {
+			return vm.Stop(r, stop)
+		}
+		desc = vm.AsBool(r)
+	}
+	if c.IsMutable() {
+		obj.Lock()
+		defer obj.Unlock()
+	}
+	if err := c.CheckNumeric("evalPolynomial", false); err != nil {
+		return vm.IoError(err)
+	}
+	x := target.Value.(float64)
+	n := c.Len()
+	r := 0.0
+	for i := 0; i < n; i++ {
+		k := n - 1 - i
+		if desc {
+			k = i
+		}
+		v, _ := c.At(k)
+		r = r*x + v
+	}
+	return vm.NewNumber(r)
+}
+
 // NumberExp is a Number method.
 //
 // exp returns e (the base of the natural logarithm) raised to the power of
@@ -485,6 +872,133 @@ func NumberFloor(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.NewNumber(math.Floor(target.Value.(float64)))
 }
 
+// NumberFloorMod is a Number method.
+//
+// floorMod returns the remainder of floored division of the target by the
+// argument, which has the sign of the argument, so (-7) floorMod(3) is 2.
+// This differs from mod for operands of different signs. It is an error for
+// the argument to be zero.
+func NumberFloorMod(vm *VM, target, locals *Object, msg *Message) *Object {
+	arg, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	if arg == 0 {
+		return vm.RaiseExceptionf("floorMod by zero")
+	}
+	r := math.Mod(target.Value.(float64), arg)
+	if r != 0 && (r < 0) != (arg < 0) {
+		r += arg
+	}
+	return vm.NewNumber(r)
+}
+
+// NumberFloorToMultipleOf is a Number method.
+//
+// floorToMultipleOf returns the greatest multiple of the argument which is not
+// greater than the target. The argument must be positive.
+func NumberFloorToMultipleOf(vm *VM, target, locals *Object, msg *Message) *Object {
+	return toMultipleOf(vm, target, locals, msg, math.Floor)
+}
+
+// NumberFromFloat32Bits is a Number method.
+//
+// fromFloat32Bits returns the Number whose IEEE-754 binary32 bit pattern is
+// the argument. This is the inverse of asFloat32Bits.
+func NumberFromFloat32Bits(vm *VM, target, locals *Object, msg *Message) *Object {
+	n, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	if n < 0 || n >= 1<<32 || n != math.Trunc(n) {
+		return vm.RaiseExceptionf("%v is not a 32-bit pattern", n)
+	}
+	return vm.NewNumber(float64(math.Float32frombits(uint32(n))))
+}
+
+// NumberFromFloatBits is a Number method.
+//
+// fromFloatBits returns the Number whose IEEE-754 binary64 bit pattern is the
+// argument. This is the inverse of asFloatBits. The argument may be a Sequence
+// holding a single uint64 item, as asFloatBits returns, or an integer Number.
+func NumberFromFloatBits(vm *VM, target, locals *Object, msg *Message) *Object {
+	_, n, obj, stop := msg.SeqOrNumArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(obj, stop)
+	}
+	if obj.Tag() == SequenceTag {
+		obj.Lock()
+		v, ok := obj.Value.(Sequence).Value.([]uint64)
+		if !ok || len(v) != 1 {
+			obj.Unlock()
+			return vm.RaiseExceptionf("argument to fromFloatBits must be a Sequence with one uint64 item")
+		}
+		b := v[0]
+		obj.Unlock()
+		return vm.NewNumber(math.Float64frombits(b))
+	}
+	if n < 0 || n >= 1<<64 || n != math.Trunc(n) {
+		return vm.RaiseExceptionf("%v is not a 64-bit pattern", n)
+	}
+	return vm.NewNumber(math.Float64frombits(uint64(n)))
+}
+
+// NumberFromDigits is a Number method.
+//
+// fromDigits returns the Number represented by a List of digits, most
+// significant first, in the given radix. This is the inverse of digitsInBase.
+// Each digit must be an integer between 0 and one less than the radix.
+func NumberFromDigits(vm *VM, target, locals *Object, msg *Message) *Object {
+	l, obj, stop := msg.ListArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(obj, stop)
+	}
+	arg, exc, stop := msg.NumberArgAt(vm, locals, 1)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	if !(arg >= 2 && arg < 37) {
+		return vm.RaiseExceptionf("conversion from base %v not supported", arg)
+	}
+	base := int(arg)
+	obj.Lock()
+	defer obj.Unlock()
+	v := 0.0
+	for i, d := range l {
+		x, ok := d.Value.(float64)
+		if !ok {
+			return vm.RaiseExceptionf("digit %d must be Number, not %s", i, vm.TypeName(d))
+		}
+		if x < 0 || x >= float64(base) || x != math.Trunc(x) {
+			return vm.RaiseExceptionf("digit %d (%v) out of range for base %d", i, x, base)
+		}
+		v = v*float64(base) + x
+	}
+	return vm.NewNumber(v)
+}
+
+// NumberGCD is a Number method.
+//
+// gcd returns the greatest common divisor of the receiver and the argument,
+// which must both be integers. The result is never negative.
+//
+//   io> 12 gcd(-18)
+//   6
+func NumberGCD(vm *VM, target, locals *Object, msg *Message) *Object {
+	a, b, err := gcdArgs(vm, target, locals, msg)
+	if err != nil {
+		return err
+	}
+	return vm.NewNumber(float64(gcd(a, b)))
+}
+
+// NumberHash is a Number method.
+//
+// hash returns a hash of the number. Numbers which are equal have equal hashes.
+func NumberHash(vm *VM, target, locals *Object, msg *Message) *Object {
+	return vm.hashNumber(vm.HashObject(target))
+}
+
 // NumberIsAlphaNumeric is a Number method.
 //
 // isAlphaNumeric is true if the target is a Unicode codepoint corresponding to
@@ -564,6 +1078,18 @@ func NumberIsOdd(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.IoBool(int64(target.Value.(float64))&1 == 1)
 }
 
+// NumberIsPrime is a Number method.
+//
+// isPrime returns whether the receiver is a prime number. The receiver must be
+// a nonnegative integer less than 2^64. The test is exact.
+func NumberIsPrime(vm *VM, target, locals *Object, msg *Message) *Object {
+	n, err := primeArg(target.Value.(float64), msg.Name())
+	if err != nil {
+		return vm.IoError(err)
+	}
+	return vm.IoBool(isPrime(n))
+}
+
 // NumberIsPrint is a Number method.
 //
 // isPrint is true if the target is a Unicode codepoint corresponding to a
@@ -596,6 +1122,52 @@ func NumberIsUppercase(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.IoBool(unicode.IsUpper(rune(target.Value.(float64))))
 }
 
+// NumberLCM is a Number method.
+//
+// lcm returns the least common multiple of the receiver and the argument,
+// which must both be integers. The result is never negative, and it is 0 if
+// either operand is 0.
+//
+//   io> 4 lcm(6)
+//   12
+func NumberLCM(vm *VM, target, locals *Object, msg *Message) *Object {
+	a, b, err := gcdArgs(vm, target, locals, msg)
+	if err != nil {
+		return err
+	}
+	if a == 0 || b == 0 {
+		return vm.NewNumber(0)
+	}
+	// Dividing first keeps the intermediate result from overflowing, and
+	// the final product is computed in floating point so that it can't.
+	return vm.NewNumber(float64(a/gcd(a, b)) * float64(b))
+}
+
+// gcdArgs gets the absolute integer values of the receiver and argument of
+// gcd or lcm. If either is not an integer, the result is an exception.
+func gcdArgs(vm *VM, target, locals *Object, msg *Message) (a, b uint64, exc *Object) {
+	arg, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return 0, 0, vm.Stop(exc, stop)
+	}
+	x := target.Value.(float64)
+	for _, v := range [...]float64{x, arg} {
+		if v != math.Trunc(v) || math.Abs(v) >= 1<<64 {
+			return 0, 0, vm.RaiseExceptionf("%s operands must be integers, not %v", msg.Name(), v)
+		}
+	}
+	return uint64(math.Abs(x)), uint64(math.Abs(arg)), nil
+}
+
+// gcd computes the greatest common divisor of a and b using the Euclidean
+// algorithm.
+func gcd(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
 // NumberLog is a Number method.
 //
 // log returns the natural logarithm of the target.
@@ -670,6 +1242,83 @@ func NumberNegate(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.NewNumber(-target.Value.(float64))
 }
 
+// NumberNextPrime is a Number method.
+//
+// nextPrime returns the smallest prime number strictly greater than the
+// receiver, which must be a nonnegative integer. An exception is raised if the
+// result would be too large to represent exactly.
+//
+//   io> 13 nextPrime
+//   17
+func NumberNextPrime(vm *VM, target, locals *Object, msg *Message) *Object {
+	n, err := primeArg(target.Value.(float64), msg.Name())
+	if err != nil {
+		return vm.IoError(err)
+	}
+	for n++; n <= 1<<53; n++ {
+		if isPrime(n) {
+			return vm.NewNumber(float64(n))
+		}
+	}
+	return vm.RaiseExceptionf("next prime after %v is too large to represent exactly", target.Value.(float64))
+}
+
+// primeArg converts the receiver of isPrime or nextPrime to an integer.
+func primeArg(v float64, name string) (uint64, error) {
+	if v < 0 || v != math.Trunc(v) || v >= 1<<64 {
+		return 0, fmt.Errorf("%s receiver must be a nonnegative integer less than 2^64, not %v", name, v)
+	}
+	return uint64(v), nil
+}
+
+// isPrime tests whether n is prime using the Miller-Rabin test with a set of
+// bases which is known to be deterministic for all 64-bit integers.
+func isPrime(n uint64) bool {
+	bases := [...]uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+	if n < 2 {
+		return false
+	}
+	for _, p := range bases {
+		if n%p == 0 {
+			return n == p
+		}
+	}
+	d, r := n-1, 0
+	for d%2 == 0 {
+		d /= 2
+		r++
+	}
+	mulmod := func(a, b uint64) uint64 {
+		hi, lo := bits.Mul64(a, b)
+		return bits.Rem64(hi, lo, n)
+	}
+	powmod := func(a, e uint64) uint64 {
+		x := uint64(1)
+		for ; e > 0; e >>= 1 {
+			if e&1 != 0 {
+				x = mulmod(x, a)
+			}
+			a = mulmod(a, a)
+		}
+		return x
+	}
+outer:
+	for _, a := range bases {
+		x := powmod(a, d)
+		if x == 1 || x == n-1 {
+			continue
+		}
+		for i := 1; i < r; i++ {
+			x = mulmod(x, x)
+			if x == n-1 {
+				continue outer
+			}
+		}
+		return false
+	}
+	return true
+}
+
 // NumberMul is a Number method.
 //
 // * is an operator which multiplies its operands.
@@ -695,7 +1344,14 @@ func NumberPow(vm *VM, target, locals *Object, msg *Message) *Object {
 
 // NumberRepeat is a Number method.
 //
-// repeat performs a loop the given number of times.
+// repeat performs a loop the given number of times. With two arguments, the
+// first is the name of a local to which the 0-based iteration index is bound:
+//
+//   io> 3 repeat(i, i println)
+//
+// The receiver is truncated to an integer. The result is that of the last
+// iteration, or the value of a break; if the loop runs zero times, including
+// when the receiver is negative, the result is nil.
 func NumberRepeat(vm *VM, target, locals *Object, msg *Message) (result *Object) {
 	if len(msg.Args) < 1 {
 		return vm.RaiseExceptionf("Number repeat requires 1 or 2 arguments")
@@ -706,7 +1362,11 @@ func NumberRepeat(vm *VM, target, locals *Object, msg *Message) (result *Object)
 		// One argument was supplied.
 		counter, eval = nil, counter
 	}
-	max := int(math.Ceil(target.Value.(float64)))
+	max := 0
+	if x := math.Trunc(target.Value.(float64)); x > 0 {
+		max = int(x)
+	}
+	result = vm.Nil
 	var control Stop
 	for i := 0; i < max; i++ {
 		if counter != nil {
@@ -748,6 +1408,30 @@ func NumberRoundDown(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.NewNumber(math.Floor(target.Value.(float64) + 0.5))
 }
 
+// NumberRoundToMultipleOf is a Number method.
+//
+// roundToMultipleOf returns the multiple of the argument nearest the target,
+// with halfway cases rounding away from zero, so 7 roundToMultipleOf(5) is 5.
+// The argument must be positive.
+func NumberRoundToMultipleOf(vm *VM, target, locals *Object, msg *Message) *Object {
+	return toMultipleOf(vm, target, locals, msg, math.Round)
+}
+
+// toMultipleOf implements the *ToMultipleOf methods, using f to round the
+// quotient of the target by the step to an integer. As the quotient is
+// computed in floating point, a target very near a multiple of a step that is
+// not exactly representable, such as 0.1, may round to an adjacent multiple.
+func toMultipleOf(vm *VM, target, locals *Object, msg *Message, f func(float64) float64) *Object {
+	step, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	if !(step > 0) || math.IsInf(step, 1) {
+		return vm.RaiseExceptionf("%s step must be positive and finite, not %v", msg.Name(), step)
+	}
+	return vm.NewNumber(f(target.Value.(float64)/step) * step)
+}
+
 // NumberShiftLeft is a Number method.
 //
 // shiftLeft returns the target as a 64-bit integer shifted left by the
@@ -1,8 +1,10 @@
 package internal_test
 
 import (
+	"math"
 	"testing"
 
+	"github.com/zephyrtronium/iolang"
 	"github.com/zephyrtronium/iolang/testutils"
 )
 
@@ -23,3 +25,328 @@ func TestNumberCache(t *testing.T) {
 		}
 	}
 }
+
+// TestNumberFloatBits tests conversions between Numbers and their IEEE-754
+// bit patterns.
+func TestNumberFloatBits(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]map[string]testutils.SourceTestCase{
+		"asFloatBits": {
+			"one":      {Source: `1 asFloatBits`, Pass: testutils.PassEqual(vm.NewSequence([]uint64{math.Float64bits(1)}, false, "number"))},
+			"negative": {Source: `(-2) asFloatBits`, Pass: testutils.PassEqual(vm.NewSequence([]uint64{math.Float64bits(-2)}, false, "number"))},
+			"zero":     {Source: `0 asFloatBits at(0)`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"exact":    {Source: `1.1 asFloatBits`, Pass: testutils.PassEqual(vm.NewSequence([]uint64{math.Float64bits(1.1)}, false, "number"))},
+			"itemType": {Source: `1 asFloatBits itemType`, Pass: testutils.PassEqual(vm.NewString("uint64"))},
+		},
+		"asFloat32Bits": {
+			"one":   {Source: `1 asFloat32Bits`, Pass: testutils.PassEqual(vm.NewNumber(0x3f800000))},
+			"tenth": {Source: `0.1 asFloat32Bits`, Pass: testutils.PassEqual(vm.NewNumber(float64(math.Float32bits(0.1))))},
+		},
+		"fromFloatBits": {
+			"one":       {Source: `Number fromFloatBits(1 asFloatBits)`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"negative":  {Source: `Number fromFloatBits((-0.5) asFloatBits)`, Pass: testutils.PassEqual(vm.NewNumber(-0.5))},
+			"negZero":   {Source: `Number fromFloatBits(2 ** 63) == 0`, Pass: testutils.PassIdentical(vm.True)},
+			"tenths":    {Source: `Number fromFloatBits(1.1 asFloatBits)`, Pass: testutils.PassEqual(vm.NewNumber(1.1))},
+			"signZero":  {Source: `1 / Number fromFloatBits((0 * -1) asFloatBits)`, Pass: testutils.PassEqual(vm.NewNumber(math.Inf(-1)))},
+			"range":     {Source: `Number fromFloatBits(-1)`, Pass: testutils.PassFailure()},
+			"fraction":  {Source: `Number fromFloatBits(1.5)`, Pass: testutils.PassFailure()},
+			"size":      {Source: `Number fromFloatBits(list(1, 2) asSequence("uint64"))`, Pass: testutils.PassFailure()},
+			"wrongType": {Source: `Number fromFloatBits("abcdefgh")`, Pass: testutils.PassFailure()},
+		},
+		"fromFloat32Bits": {
+			"one":   {Source: `Number fromFloat32Bits(1065353216)`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"tenth": {Source: `Number fromFloat32Bits(0.1 asFloat32Bits)`, Pass: testutils.PassEqual(vm.NewNumber(float64(float32(0.1))))},
+			"range": {Source: `Number fromFloat32Bits(2 ** 32)`, Pass: testutils.PassFailure()},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			for name, s := range c {
+				t.Run(name, s.TestFunc("TestNumberFloatBits"))
+			}
+		})
+	}
+}
+
+// TestNumberRepeat tests Number repeat.
+func TestNumberRepeat(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"count":     {Source: `Object clone do(n := 0; 3 repeat(n = n + 1)) n`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+		"index":     {Source: `Object clone do(r := list; 3 repeat(i, r append(i))) r`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(0), vm.NewNumber(1), vm.NewNumber(2)))},
+		"truncate":  {Source: `Object clone do(n := 0; 2.9 repeat(n = n + 1)) n`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+		"result":    {Source: `3 repeat(i, i * 2)`, Pass: testutils.PassEqual(vm.NewNumber(4))},
+		"zero":      {Source: `0 repeat(1)`, Pass: testutils.PassIdentical(vm.Nil)},
+		"negative":  {Source: `(-3) repeat(1)`, Pass: testutils.PassIdentical(vm.Nil)},
+		"break":     {Source: `5 repeat(i, if(i == 2, break(i * 10)))`, Pass: testutils.PassEqual(vm.NewNumber(20))},
+		"continue":  {Source: `Object clone do(n := 0; 4 repeat(i, if(i isOdd, continue); n = n + 1)) n`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+		"return":    {Source: `3 repeat(i, return i)`, Pass: testutils.PassControl(vm.NewNumber(0), iolang.ReturnStop)},
+		"exception": {Source: `3 repeat(Exception raise)`, Pass: testutils.PassFailure()},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestNumberRepeat"))
+	}
+}
+
+// TestNumberAsInteger tests asInteger with each rounding mode.
+func TestNumberAsInteger(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"default":   {Source: `2.7 asInteger`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+		"trunc":     {Source: `(-2.7) asInteger("trunc")`, Pass: testutils.PassEqual(vm.NewNumber(-2))},
+		"floor":     {Source: `(-2.2) asInteger("floor")`, Pass: testutils.PassEqual(vm.NewNumber(-3))},
+		"ceil":      {Source: `2.2 asInteger("ceil")`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+		"round":     {Source: `2.5 asInteger("round")`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+		"roundNeg":  {Source: `(-2.5) asInteger("round")`, Pass: testutils.PassEqual(vm.NewNumber(-3))},
+		"integral":  {Source: `4 asInteger("floor")`, Pass: testutils.PassEqual(vm.NewNumber(4))},
+		"nan":       {Source: `Number constants nan asInteger`, Pass: testutils.PassFailure()},
+		"inf":       {Source: `(1 / 0) asInteger("floor")`, Pass: testutils.PassFailure()},
+		"badMode":   {Source: `2.5 asInteger("up")`, Pass: testutils.PassFailure()},
+		"emptyMode": {Source: `2.5 asInteger("")`, Pass: testutils.PassFailure()},
+		"notString": {Source: `2.5 asInteger(1)`, Pass: testutils.PassFailure()},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestNumberAsInteger"))
+	}
+}
+
+// TestNumberAsStringInBase tests conversion of Numbers to strings in other
+// radices.
+func TestNumberAsStringInBase(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"integer":    {Source: `255 asStringInBase(16)`, Pass: testutils.PassEqual(vm.NewString("ff"))},
+		"zero":       {Source: `0 asStringInBase(7)`, Pass: testutils.PassEqual(vm.NewString("0"))},
+		"half":       {Source: `0.5 asStringInBase(2)`, Pass: testutils.PassEqual(vm.NewString("0.1"))},
+		"tenthBase3": {Source: `0.1 asStringInBase(3, 8)`, Pass: testutils.PassEqual(vm.NewString("0.00220022"))},
+		"negative":   {Source: `(-2.75) asStringInBase(2)`, Pass: testutils.PassEqual(vm.NewString("-10.11"))},
+		"trimmed":    {Source: `2.5 asStringInBase(4, 10)`, Pass: testutils.PassEqual(vm.NewString("2.2"))},
+		"capped":     {Source: `(1/3) asStringInBase(10, 4)`, Pass: testutils.PassEqual(vm.NewString("0.3333"))},
+		"noDigits":   {Source: `3.75 asStringInBase(10, 0)`, Pass: testutils.PassEqual(vm.NewString("3"))},
+		"large":      {Source: `(2 ** 70) asStringInBase(16)`, Pass: testutils.PassEqual(vm.NewString("400000000000000000"))},
+		"group":      {Source: `65535 asStringInBase(16, 2, ":")`, Pass: testutils.PassEqual(vm.NewString("ff:ff"))},
+		"groupShort": {Source: `255 asStringInBase(16, 2, ":")`, Pass: testutils.PassEqual(vm.NewString("ff"))},
+		"groupOdd":   {Source: `(-1023) asStringInBase(2, 4, "_")`, Pass: testutils.PassEqual(vm.NewString("-11_1111_1111"))},
+		"groupFrac":  {Source: `1023.5 asStringInBase(2, 1, 4, "_")`, Pass: testutils.PassEqual(vm.NewString("11_1111_1111.1"))},
+		"groupZero":  {Source: `255 asStringInBase(16, 0, ":")`, Pass: testutils.PassFailure()},
+		"groupSep":   {Source: `255 asStringInBase(16, 2, 3)`, Pass: testutils.PassFailure()},
+		"lowBase":    {Source: `1 asStringInBase(1)`, Pass: testutils.PassFailure()},
+		"highBase":   {Source: `1 asStringInBase(37)`, Pass: testutils.PassFailure()},
+		"negDigits":  {Source: `1 asStringInBase(2, -1)`, Pass: testutils.PassFailure()},
+		"nan":        {Source: `(0/0) asStringInBase(2)`, Pass: testutils.PassFailure()},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestNumberAsStringInBase"))
+	}
+}
+
+// TestNumberAsGroupedString tests formatting Numbers with digit grouping.
+func TestNumberAsGroupedString(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"default":   {Source: `1234567.89 asGroupedString`, Pass: testutils.PassEqual(vm.NewString("1,234,567.89"))},
+		"small":     {Source: `123 asGroupedString`, Pass: testutils.PassEqual(vm.NewString("123"))},
+		"exact":     {Source: `123456 asGroupedString`, Pass: testutils.PassEqual(vm.NewString("123,456"))},
+		"negative":  {Source: `(-1234567) asGroupedString`, Pass: testutils.PassEqual(vm.NewString("-1,234,567"))},
+		"zero":      {Source: `0 asGroupedString`, Pass: testutils.PassEqual(vm.NewString("0"))},
+		"separator": {Source: `1234567.89 asGroupedString(".", ",")`, Pass: testutils.PassEqual(vm.NewString("1.234.567,89"))},
+		"precision": {Source: `(-1234.5) asGroupedString(",", ".", 2)`, Pass: testutils.PassEqual(vm.NewString("-1,234.50"))},
+		"round":     {Source: `999999.996 asGroupedString(",", ".", 2)`, Pass: testutils.PassEqual(vm.NewString("1,000,000.00"))},
+		"noDigits":  {Source: `1234.5 asGroupedString(" ", ".", 0)`, Pass: testutils.PassEqual(vm.NewString("1 234"))},
+		"negZero":   {Source: `(-0.001) asGroupedString(",", ".", 2)`, Pass: testutils.PassEqual(vm.NewString("0.00"))},
+		"badPrec":   {Source: `1 asGroupedString(",", ".", -1)`, Pass: testutils.PassFailure()},
+		"inf":       {Source: `(1/0) asGroupedString`, Pass: testutils.PassFailure()},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestNumberAsGroupedString"))
+	}
+}
+
+// TestNumberAsPaddedString tests asPaddedString.
+func TestNumberAsPaddedString(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"pad":      {Source: `7 asPaddedString(3)`, Pass: testutils.PassEqual(vm.NewString("007"))},
+		"negative": {Source: `(-7) asPaddedString(3)`, Pass: testutils.PassEqual(vm.NewString("-007"))},
+		"wide":     {Source: `12345 asPaddedString(3)`, Pass: testutils.PassEqual(vm.NewString("12345"))},
+		"truncate": {Source: `7.9 asPaddedString(2)`, Pass: testutils.PassEqual(vm.NewString("07"))},
+		"zero":     {Source: `0 asPaddedString(0)`, Pass: testutils.PassEqual(vm.NewString("0"))},
+		"negWidth": {Source: `5 asPaddedString(-3)`, Pass: testutils.PassEqual(vm.NewString("5"))},
+		"nan":      {Source: `Number constants nan asPaddedString(3)`, Pass: testutils.PassFailure()},
+		"inf":      {Source: `(1 / 0) asPaddedString(3)`, Pass: testutils.PassFailure()},
+		"nanWidth": {Source: `5 asPaddedString(Number constants nan)`, Pass: testutils.PassFailure()},
+		"huge":     {Source: `5 asPaddedString(2 ** 64)`, Pass: testutils.PassFailure()},
+		"notNum":   {Source: `5 asPaddedString("3")`, Pass: testutils.PassFailure()},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestNumberAsPaddedString"))
+	}
+}
+
+// TestNumberDigits tests digitsInBase and fromDigits.
+func TestNumberDigits(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"binary":       {Source: `6 digitsInBase(2)`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(1), vm.NewNumber(1), vm.NewNumber(0)))},
+		"hex":          {Source: `255 digitsInBase(16)`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(15), vm.NewNumber(15)))},
+		"zero":         {Source: `0 digitsInBase(10)`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(0)))},
+		"truncate":     {Source: `12.9 digitsInBase(10)`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(1), vm.NewNumber(2)))},
+		"base36":       {Source: `35 digitsInBase(36)`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(35)))},
+		"negative":     {Source: `(-1) digitsInBase(10)`, Pass: testutils.PassFailure()},
+		"inf":          {Source: `(1 / 0) digitsInBase(10)`, Pass: testutils.PassFailure()},
+		"nan":          {Source: `Number constants nan digitsInBase(10)`, Pass: testutils.PassFailure()},
+		"huge":         {Source: `(2 ** 64) digitsInBase(10)`, Pass: testutils.PassFailure()},
+		"base1":        {Source: `5 digitsInBase(1)`, Pass: testutils.PassFailure()},
+		"base37":       {Source: `5 digitsInBase(37)`, Pass: testutils.PassFailure()},
+		"baseNan":      {Source: `5 digitsInBase(Number constants nan)`, Pass: testutils.PassFailure()},
+		"baseType":     {Source: `5 digitsInBase("10")`, Pass: testutils.PassFailure()},
+		"from":         {Source: `Number fromDigits(list(1, 1, 0), 2)`, Pass: testutils.PassEqual(vm.NewNumber(6))},
+		"fromEmpty":    {Source: `Number fromDigits(list, 10)`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+		"fromRange":    {Source: `Number fromDigits(list(1, 2), 2)`, Pass: testutils.PassFailure()},
+		"fromFrac":     {Source: `Number fromDigits(list(1.5), 10)`, Pass: testutils.PassFailure()},
+		"fromNeg":      {Source: `Number fromDigits(list(-1), 10)`, Pass: testutils.PassFailure()},
+		"fromType":     {Source: `Number fromDigits(list("1"), 10)`, Pass: testutils.PassFailure()},
+		"fromNotList":  {Source: `Number fromDigits("1", 10)`, Pass: testutils.PassFailure()},
+		"fromBase1":    {Source: `Number fromDigits(list(0), 1)`, Pass: testutils.PassFailure()},
+		"fromBase37":   {Source: `Number fromDigits(list(0), 37)`, Pass: testutils.PassFailure()},
+		"fromBaseNan":  {Source: `Number fromDigits(list(0), Number constants nan)`, Pass: testutils.PassFailure()},
+		"roundTrip":    {Source: `list(2, 3, 8, 10, 16, 36) map(b, Number fromDigits(123456789 digitsInBase(b), b)) unique`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(123456789)))},
+		"roundTripMax": {Source: `Number fromDigits((2 ** 53) digitsInBase(7), 7) == 2 ** 53`, Pass: testutils.PassIdentical(vm.True)},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestNumberDigits"))
+	}
+}
+
+// TestNumberEvalPolynomial tests evaluating polynomials with Horner's method.
+func TestNumberEvalPolynomial(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"ascending":  {Source: `2 evalPolynomial(list(1, 0, 3) asSequence)`, Pass: testutils.PassEqual(vm.NewNumber(13))},
+		"descending": {Source: `2 evalPolynomial(list(1, 0, 3) asSequence, true)`, Pass: testutils.PassEqual(vm.NewNumber(7))},
+		"cubic":      {Source: `(-1.5) evalPolynomial(list(-6, 11, -6, 1) asSequence)`, Pass: testutils.PassEqual(vm.NewNumber(-39.375))},
+		"root":       {Source: `3 evalPolynomial(list(-6, 11, -6, 1) asSequence)`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+		"empty":      {Source: `5 evalPolynomial(list() asSequence)`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+		"constant":   {Source: `5 evalPolynomial(list(4) asSequence, true)`, Pass: testutils.PassEqual(vm.NewNumber(4))},
+		"notNumeric": {Source: `2 evalPolynomial("abc")`, Pass: testutils.PassFailure()},
+		"bad":        {Source: `2 evalPolynomial(1)`, Pass: testutils.PassFailure()},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestNumberEvalPolynomial"))
+	}
+}
+
+// TestNumberFloorMod tests floorMod.
+func TestNumberFloorMod(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"positive":     {Source: `7 floorMod(3)`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+		"negative":     {Source: `(-7) floorMod(3)`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+		"negDivisor":   {Source: `7 floorMod(-3)`, Pass: testutils.PassEqual(vm.NewNumber(-2))},
+		"bothNegative": {Source: `(-7) floorMod(-3)`, Pass: testutils.PassEqual(vm.NewNumber(-1))},
+		"exact":        {Source: `(-6) floorMod(3)`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+		"fraction":     {Source: `(-1.5) floorMod(1)`, Pass: testutils.PassEqual(vm.NewNumber(0.5))},
+		"mod":          {Source: `(-7) mod(3)`, Pass: testutils.PassEqual(vm.NewNumber(-1))},
+		"zero":         {Source: `7 floorMod(0)`, Pass: testutils.PassFailure()},
+		"zeroTarget":   {Source: `0 floorMod(0)`, Pass: testutils.PassFailure()},
+		"notNum":       {Source: `7 floorMod("3")`, Pass: testutils.PassFailure()},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestNumberFloorMod"))
+	}
+}
+
+// TestNumberToMultipleOf tests ceilToMultipleOf, floorToMultipleOf, and
+// roundToMultipleOf.
+func TestNumberToMultipleOf(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"ceil":         {Source: `7 ceilToMultipleOf(5)`, Pass: testutils.PassEqual(vm.NewNumber(10))},
+		"ceilExact":    {Source: `10 ceilToMultipleOf(5)`, Pass: testutils.PassEqual(vm.NewNumber(10))},
+		"ceilNeg":      {Source: `(-7) ceilToMultipleOf(5)`, Pass: testutils.PassEqual(vm.NewNumber(-5))},
+		"ceilFrac":     {Source: `0.3 ceilToMultipleOf(0.25)`, Pass: testutils.PassEqual(vm.NewNumber(0.5))},
+		"floor":        {Source: `7 floorToMultipleOf(5)`, Pass: testutils.PassEqual(vm.NewNumber(5))},
+		"floorNeg":     {Source: `(-7) floorToMultipleOf(5)`, Pass: testutils.PassEqual(vm.NewNumber(-10))},
+		"floorZero":    {Source: `0 floorToMultipleOf(3)`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+		"round":        {Source: `7 roundToMultipleOf(5)`, Pass: testutils.PassEqual(vm.NewNumber(5))},
+		"roundUp":      {Source: `8 roundToMultipleOf(5)`, Pass: testutils.PassEqual(vm.NewNumber(10))},
+		"roundHalf":    {Source: `(-7.5) roundToMultipleOf(5)`, Pass: testutils.PassEqual(vm.NewNumber(-10))},
+		"ceilZero":     {Source: `7 ceilToMultipleOf(0)`, Pass: testutils.PassFailure()},
+		"floorNegStep": {Source: `7 floorToMultipleOf(-5)`, Pass: testutils.PassFailure()},
+		"roundNan":     {Source: `7 roundToMultipleOf(Number constants nan)`, Pass: testutils.PassFailure()},
+		"roundInf":     {Source: `7 roundToMultipleOf(1 / 0)`, Pass: testutils.PassFailure()},
+		"notNum":       {Source: `7 ceilToMultipleOf("5")`, Pass: testutils.PassFailure()},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestNumberToMultipleOf"))
+	}
+}
+
+// TestNumberClamp tests clamping Numbers to a range.
+func TestNumberClamp(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"below":    {Source: `(-3) clamp(0, 10)`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+		"above":    {Source: `12 clamp(0, 10)`, Pass: testutils.PassEqual(vm.NewNumber(10))},
+		"within":   {Source: `2.5 clamp(0, 10)`, Pass: testutils.PassEqual(vm.NewNumber(2.5))},
+		"bound":    {Source: `10 clamp(0, 10)`, Pass: testutils.PassEqual(vm.NewNumber(10))},
+		"point":    {Source: `7 clamp(3, 3)`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+		"nan":      {Source: `Number constants nan clamp(0, 1) isNan`, Pass: testutils.PassIdentical(vm.True)},
+		"reversed": {Source: `5 clamp(10, 0)`, Pass: testutils.PassFailure()},
+		"nanBound": {Source: `5 clamp(Number constants nan, 10)`, Pass: testutils.PassFailure()},
+		"notNum":   {Source: `5 clamp("a", 10)`, Pass: testutils.PassFailure()},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestNumberClamp"))
+	}
+}
+
+// TestNumberPrimes tests isPrime and nextPrime.
+func TestNumberPrimes(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"zero":        {Source: `0 isPrime`, Pass: testutils.PassIdentical(vm.False)},
+		"one":         {Source: `1 isPrime`, Pass: testutils.PassIdentical(vm.False)},
+		"two":         {Source: `2 isPrime`, Pass: testutils.PassIdentical(vm.True)},
+		"small":       {Source: `list(2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13) select(isPrime)`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(2), vm.NewNumber(3), vm.NewNumber(5), vm.NewNumber(7), vm.NewNumber(11), vm.NewNumber(13)))},
+		"carmichael":  {Source: `561 isPrime`, Pass: testutils.PassIdentical(vm.False)},
+		"square":      {Source: `(1000003 * 1000003) isPrime`, Pass: testutils.PassIdentical(vm.False)},
+		"mersenne":    {Source: `(2 ** 31 - 1) isPrime`, Pass: testutils.PassIdentical(vm.True)},
+		"large":       {Source: `9007199254740881 isPrime`, Pass: testutils.PassIdentical(vm.True)},
+		"largeComp":   {Source: `9007199254740883 isPrime`, Pass: testutils.PassIdentical(vm.False)},
+		"pseudoprime": {Source: `3215031751 isPrime`, Pass: testutils.PassIdentical(vm.False)},
+		"fraction":    {Source: `2.5 isPrime`, Pass: testutils.PassFailure()},
+		"negative":    {Source: `-7 isPrime`, Pass: testutils.PassFailure()},
+		"nextZero":    {Source: `0 nextPrime`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+		"nextPrime":   {Source: `13 nextPrime`, Pass: testutils.PassEqual(vm.NewNumber(17))},
+		"nextComp":    {Source: `24 nextPrime`, Pass: testutils.PassEqual(vm.NewNumber(29))},
+		"nextLarge":   {Source: `9007199254740880 nextPrime`, Pass: testutils.PassEqual(vm.NewNumber(9007199254740881))},
+		"nextTooBig":  {Source: `(2 ** 53) nextPrime`, Pass: testutils.PassFailure()},
+		"nextFrac":    {Source: `1.5 nextPrime`, Pass: testutils.PassFailure()},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestNumberPrimes"))
+	}
+}
+
+// TestNumberGCD tests gcd and lcm.
+func TestNumberGCD(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"gcd":         {Source: `12 gcd(18)`, Pass: testutils.PassEqual(vm.NewNumber(6))},
+		"gcdNegative": {Source: `(-12) gcd(18)`, Pass: testutils.PassEqual(vm.NewNumber(6))},
+		"gcdCoprime":  {Source: `35 gcd(64)`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+		"gcdZero":     {Source: `0 gcd(7)`, Pass: testutils.PassEqual(vm.NewNumber(7))},
+		"gcdZeros":    {Source: `0 gcd(0)`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+		"gcdFrac":     {Source: `12 gcd(1.5)`, Pass: testutils.PassFailure()},
+		"lcm":         {Source: `4 lcm(6)`, Pass: testutils.PassEqual(vm.NewNumber(12))},
+		"lcmNegative": {Source: `4 lcm(-6)`, Pass: testutils.PassEqual(vm.NewNumber(12))},
+		"lcmZero":     {Source: `0 lcm(6)`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+		"lcmLarge":    {Source: `(2 ** 40) lcm(3 * 2 ** 40)`, Pass: testutils.PassEqual(vm.NewNumber(3 << 40))},
+		"lcmFrac":     {Source: `2.5 lcm(2)`, Pass: testutils.PassFailure()},
+		"lcmNaN":      {Source: `2 lcm(Number constants nan)`, Pass: testutils.PassFailure()},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestNumberGCD"))
+	}
+}
@@ -3,6 +3,7 @@ package internal
 import (
 	"fmt"
 	"os"
+	"reflect"
 	"strings"
 	"sync"
 	"time"
@@ -26,6 +27,12 @@ type Object struct {
 	Value interface{}
 	// tag is the type indicator of the object.
 	tag Tag
+	// frozen indicates that the object's slots may not be changed through Io
+	// methods. It is guarded by the object's lock.
+	frozen bool
+	// docs holds documentation for the object's slots, set by setSlotDoc. It
+	// is guarded by the object's lock.
+	docs map[string]string
 }
 
 // Tag is a type indicator for iolang objects. Tag values must be comparable.
@@ -111,6 +118,47 @@ func (o *Object) IsKindOf(kind *Object) bool {
 	return false
 }
 
+// Freeze marks the object as frozen, so that Io methods which change its slots
+// will raise an exception instead. Freezing is shallow; neither the object's
+// protos nor its slot values are affected.
+func (o *Object) Freeze() {
+	o.Lock()
+	o.frozen = true
+	o.Unlock()
+}
+
+// IsFrozen returns whether the object has been frozen.
+func (o *Object) IsFrozen() bool {
+	o.Lock()
+	r := o.frozen
+	o.Unlock()
+	return r
+}
+
+// SetSlotDoc sets the documentation for a slot of the object. The slot need
+// not exist. An empty doc removes any existing documentation.
+func (o *Object) SetSlotDoc(slot, doc string) {
+	o.Lock()
+	if doc == "" {
+		delete(o.docs, slot)
+	} else {
+		if o.docs == nil {
+			o.docs = make(map[string]string)
+		}
+		o.docs[slot] = doc
+	}
+	o.Unlock()
+}
+
+// SlotDoc returns the documentation set on the object for a slot. It does not
+// check the object's protos.
+func (o *Object) SlotDoc(slot string) (doc string, ok bool) {
+	o.Lock()
+	doc, ok = o.docs[slot]
+	o.Unlock()
+	return doc, ok
+}
+
 // Tag returns the object's type indicator.
 func (o *Object) Tag() Tag {
 	return o.tag
@@ -144,81 +192,126 @@ func (t BasicTag) String() string {
 	return string(t)
 }
 
+// tagSlotAlias is the Tag type for slot aliases created by aliasSlot.
+type tagSlotAlias struct{}
+
+// Activate performs the aliased slot on the target.
+func (tagSlotAlias) Activate(vm *VM, self, target, locals, context *Object, msg *Message) *Object {
+	name := self.Value.(string)
+	v, proto := vm.GetSlot(target, name)
+	if proto == nil {
+		return vm.RaiseExceptionf("%s does not respond to %s (aliased as %s)", vm.TypeName(target), name, msg.Name())
+	}
+	return v.Activate(vm, target, locals, proto, msg)
+}
+
+func (tagSlotAlias) CloneValue(value interface{}) interface{} {
+	return value
+}
+
+func (tagSlotAlias) String() string {
+	return "SlotAlias"
+}
+
+// SlotAliasTag is the Tag for slot aliases. Activate looks up the aliased slot
+// on the target and activates its current value. CloneValue returns the same
+// slot name.
+var SlotAliasTag tagSlotAlias
+
 // initObject sets up the "base" object that is the first proto of all other
 // built-in types.
 func (vm *VM) initObject() {
 	vm.BaseObject.SetProtos(vm.Lobby)
 	slots := Slots{
-		"":                     vm.NewCFunction(ObjectEvalArg, nil),
-		"!=":                   vm.NewCFunction(ObjectNotEqual, nil),
-		"<":                    vm.NewCFunction(ObjectLess, nil),
-		"<=":                   vm.NewCFunction(ObjectLessOrEqual, nil),
-		"==":                   vm.NewCFunction(ObjectEqual, nil),
-		">":                    vm.NewCFunction(ObjectGreater, nil),
-		">=":                   vm.NewCFunction(ObjectGreaterOrEqual, nil),
-		"ancestorWithSlot":     vm.NewCFunction(ObjectAncestorWithSlot, nil),
-		"appendProto":          vm.NewCFunction(ObjectAppendProto, nil),
-		"asGoRepr":             vm.NewCFunction(ObjectAsGoRepr, nil),
-		"asString":             vm.NewCFunction(ObjectAsString, nil),
-		"block":                vm.NewCFunction(ObjectBlock, nil), // block.go
-		"break":                vm.NewCFunction(ObjectBreak, nil), // control.go
-		"clone":                vm.NewCFunction(ObjectClone, nil),
-		"cloneWithoutInit":     vm.NewCFunction(ObjectCloneWithoutInit, nil),
-		"compare":              vm.NewCFunction(ObjectCompare, nil),
-		"contextWithSlot":      vm.NewCFunction(ObjectContextWithSlot, nil),
-		"continue":             vm.NewCFunction(ObjectContinue, nil), // control.go
-		"do":                   vm.NewCFunction(ObjectDo, nil),
-		"doFile":               vm.NewCFunction(ObjectDoFile, nil),
-		"doMessage":            vm.NewCFunction(ObjectDoMessage, nil),
-		"doString":             vm.NewCFunction(ObjectDoString, nil),
-		"evalArgAndReturnNil":  vm.NewCFunction(ObjectEvalArgAndReturnNil, nil),
-		"evalArgAndReturnSelf": vm.NewCFunction(ObjectEvalArgAndReturnSelf, nil),
-		"for":                  vm.NewCFunction(ObjectFor, nil), // control.go
-		"foreachSlot":          vm.NewCFunction(ObjectForeachSlot, nil),
-		"getLocalSlot":         vm.NewCFunction(ObjectGetLocalSlot, nil),
-		"getSlot":              vm.NewCFunction(ObjectGetSlot, nil),
-		"hasLocalSlot":         vm.NewCFunction(ObjectHasLocalSlot, nil),
-		"if":                   vm.NewCFunction(ObjectIf, nil), // control.go
-		"isError":              vm.False,
-		"isIdenticalTo":        vm.NewCFunction(ObjectIsIdenticalTo, nil),
-		"isKindOf":             vm.NewCFunction(ObjectIsKindOf, nil),
-		"isNil":                vm.False,
-		"isTrue":               vm.True,
-		"lexicalDo":            vm.NewCFunction(ObjectLexicalDo, nil),
-		"loop":                 vm.NewCFunction(ObjectLoop, nil), // control.go
-		"message":              vm.NewCFunction(ObjectMessage, nil),
-		"method":               vm.NewCFunction(ObjectMethod, nil), // block.go
-		"not":                  vm.Nil,
-		"or":                   vm.True,
-		"perform":              vm.NewCFunction(ObjectPerform, nil),
-		"performWithArgList":   vm.NewCFunction(ObjectPerformWithArgList, nil),
-		"prependProto":         vm.NewCFunction(ObjectPrependProto, nil),
-		"print":                vm.NewCFunction(ObjectPrint, nil),
-		"protos":               vm.NewCFunction(ObjectProtos, nil),
-		"removeAllProtos":      vm.NewCFunction(ObjectRemoveAllProtos, nil),
-		"removeAllSlots":       vm.NewCFunction(ObjectRemoveAllSlots, nil),
-		"removeProto":          vm.NewCFunction(ObjectRemoveProto, nil),
-		"removeSlot":           vm.NewCFunction(ObjectRemoveSlot, nil),
-		"return":               vm.NewCFunction(ObjectReturn, nil), // control.go
-		"setProto":             vm.NewCFunction(ObjectSetProto, nil),
-		"setProtos":            vm.NewCFunction(ObjectSetProtos, nil),
-		"setSlot":              vm.NewCFunction(ObjectSetSlot, nil),
-		"shallowCopy":          vm.NewCFunction(ObjectShallowCopy, nil),
-		"slotNames":            vm.NewCFunction(ObjectSlotNames, nil),
-		"slotValues":           vm.NewCFunction(ObjectSlotValues, nil),
-		"stopStatus":           vm.NewCFunction(ObjectStopStatus, nil),
-		"thisContext":          vm.NewCFunction(ObjectThisContext, nil),
-		"thisLocalContext":     vm.NewCFunction(ObjectThisLocalContext, nil),
-		"thisMessage":          vm.NewCFunction(ObjectThisMessage, nil),
-		"try":                  vm.NewCFunction(ObjectTry, nil),
-		"type":                 vm.NewString("Object"),
-		"uniqueId":             vm.NewCFunction(ObjectUniqueID, nil),
-		"updateSlot":           vm.NewCFunction(ObjectUpdateSlot, nil),
-		"wait":                 vm.NewCFunction(ObjectWait, nil),
-		"while":                vm.NewCFunction(ObjectWhile, nil), // control.go
+		"":                       vm.NewCFunction(ObjectEvalArg, nil),
+		"!=":                     vm.NewCFunction(ObjectNotEqual, nil),
+		"<":                      vm.NewCFunction(ObjectLess, nil),
+		"<=":                     vm.NewCFunction(ObjectLessOrEqual, nil),
+		"==":                     vm.NewCFunction(ObjectEqual, nil),
+		">":                      vm.NewCFunction(ObjectGreater, nil),
+		">=":                     vm.NewCFunction(ObjectGreaterOrEqual, nil),
+		"aliasSlot":              vm.NewCFunction(ObjectAliasSlot, nil),
+		"ancestorWithSlot":       vm.NewCFunction(ObjectAncestorWithSlot, nil),
+		"appendProto":            vm.NewCFunction(ObjectAppendProto, nil),
+		"asGoRepr":               vm.NewCFunction(ObjectAsGoRepr, nil),
+		"asString":               vm.NewCFunction(ObjectAsString, nil),
+		"block":                  vm.NewCFunction(ObjectBlock, nil),     // block.go
+		"break":                  vm.NewCFunction(ObjectBreak, nil),     // control.go
+		"callStack":              vm.NewCFunction(ObjectCallStack, nil), // block.go
+		"clone":                  vm.NewCFunction(ObjectClone, nil),
+		"cloneWithoutInit":       vm.NewCFunction(ObjectCloneWithoutInit, nil),
+		"compare":                vm.NewCFunction(ObjectCompare, nil),
+		"contextWithSlot":        vm.NewCFunction(ObjectContextWithSlot, nil),
+		"continue":               vm.NewCFunction(ObjectContinue, nil), // control.go
+		"do":                     vm.NewCFunction(ObjectDo, nil),
+		"doFile":                 vm.NewCFunction(ObjectDoFile, nil),
+		"doMessage":              vm.NewCFunction(ObjectDoMessage, nil),
+		"doString":               vm.NewCFunction(ObjectDoString, nil),
+		"doWithLocals":           vm.NewCFunction(ObjectDoWithLocals, nil),
+		"docOf":                  vm.NewCFunction(ObjectDocOf, nil),
+		"evalArgAndReturnNil":    vm.NewCFunction(ObjectEvalArgAndReturnNil, nil),
+		"evalArgAndReturnSelf":   vm.NewCFunction(ObjectEvalArgAndReturnSelf, nil),
+		"for":                    vm.NewCFunction(ObjectFor, nil), // control.go
+		"foreachSlot":            vm.NewCFunction(ObjectForeachSlot, nil),
+		"freeze":                 vm.NewCFunction(ObjectFreeze, nil),
+		"freezeDeep":             vm.NewCFunction(ObjectFreezeDeep, nil),
+		"getLocalSlot":           vm.NewCFunction(ObjectGetLocalSlot, nil),
+		"getSlot":                vm.NewCFunction(ObjectGetSlot, nil),
+		"hasLocalSlot":           vm.NewCFunction(ObjectHasLocalSlot, nil),
+		"if":                     vm.NewCFunction(ObjectIf, nil), // control.go
+		"isError":                vm.False,
+		"isFrozen":               vm.NewCFunction(ObjectIsFrozen, nil),
+		"isIdenticalTo":          vm.NewCFunction(ObjectIsIdenticalTo, nil),
+		"isKindOf":               vm.NewCFunction(ObjectIsKindOf, nil),
+		"isNil":                  vm.False,
+		"isTrue":                 vm.True,
+		"lexicalDo":              vm.NewCFunction(ObjectLexicalDo, nil),
+		"loop":                   vm.NewCFunction(ObjectLoop, nil), // control.go
+		"message":                vm.NewCFunction(ObjectMessage, nil),
+		"method":                 vm.NewCFunction(ObjectMethod, nil), // block.go
+		"not":                    vm.Nil,
+		"or":                     vm.True,
+		"perform":                vm.NewCFunction(ObjectPerform, nil),
+		"performLater":           vm.NewCFunction(ObjectPerformLater, nil),
+		"performWithArgList":     vm.NewCFunction(ObjectPerformWithArgList, nil),
+		"performWithMessageList": vm.NewCFunction(ObjectPerformWithMessageList, nil),
+		"performWithNamedArgs":   vm.NewCFunction(ObjectPerformWithNamedArgs, nil),
+		"prependProto":           vm.NewCFunction(ObjectPrependProto, nil),
+		"print":                  vm.NewCFunction(ObjectPrint, nil),
+		"protos":                 vm.NewCFunction(ObjectProtos, nil),
+		"removeAllProtos":        vm.NewCFunction(ObjectRemoveAllProtos, nil),
+		"removeAllSlots":         vm.NewCFunction(ObjectRemoveAllSlots, nil),
+		"removeProto":            vm.NewCFunction(ObjectRemoveProto, nil),
+		"removeSlot":             vm.NewCFunction(ObjectRemoveSlot, nil),
+		"removeSlotEverywhere":   vm.NewCFunction(ObjectRemoveSlotEverywhere, nil),
+		"return":                 vm.NewCFunction(ObjectReturn, nil), // control.go
+		"setProto":               vm.NewCFunction(ObjectSetProto, nil),
+		"setProtos":              vm.NewCFunction(ObjectSetProtos, nil),
+		"setSlot":                vm.NewCFunction(ObjectSetSlot, nil),
+		"setSlotDoc":             vm.NewCFunction(ObjectSetSlotDoc, nil),
+		"setSlotIfAbsent":        vm.NewCFunction(ObjectSetSlotIfAbsent, nil),
+		"shallowCopy":            vm.NewCFunction(ObjectShallowCopy, nil),
+		"slotNames":              vm.NewCFunction(ObjectSlotNames, nil),
+		"slotValues":             vm.NewCFunction(ObjectSlotValues, nil),
+		"stopStatus":             vm.NewCFunction(ObjectStopStatus, nil),
+		"structurallyEquals":     vm.NewCFunction(ObjectStructurallyEquals, nil),
+		"tap":                    vm.NewCFunction(ObjectTap, nil),
+		"thisContext":            vm.NewCFunction(ObjectThisContext, nil),
+		"thisLocalContext":       vm.NewCFunction(ObjectThisLocalContext, nil),
+		"thisMessage":            vm.NewCFunction(ObjectThisMessage, nil),
+		"try":                    vm.NewCFunction(ObjectTry, nil),
+		"type":                   vm.NewString("Object"),
+		"uniqueId":               vm.NewCFunction(ObjectUniqueID, nil),
+		"updateSlot":             vm.NewCFunction(ObjectUpdateSlot, nil),
+		"wait":                   vm.NewCFunction(ObjectWait, nil),
+		"while":                  vm.NewCFunction(ObjectWhile, nil), // control.go
 	}
 	slots["evalArg"] = slots[""]
 	slots["ifError"] = slots["thisContext"]
+	// The nil coalescing methods are lazy: for any object other than nil,
+	// ifNil and ifNilEval return the receiver without evaluating their
+	// arguments, while ifNonNil and ifNonNilEval evaluate them. nil overrides
+	// all four with the converse behavior in 00_Object.io.
 	slots["ifNil"] = slots["thisContext"]
 	slots["ifNilEval"] = slots["thisContext"]
 	slots["ifNonNil"] = slots["evalArgAndReturnSelf"]
@@ -300,6 +393,9 @@ func ObjectSetSlot(vm *VM, target, locals *Object, msg *Message) *Object {
 	if stop != NoStop {
 		return vm.Stop(exc, stop)
 	}
+	if target.IsFrozen() {
+		return vm.RaiseExceptionf("can't set slot %s on frozen object", slot)
+	}
 	sy := vm.SetSlotSync(target, slot)
 	v, stop := msg.EvalArgAt(vm, locals, 1)
 	if stop == NoStop {
@@ -309,6 +405,34 @@ func ObjectSetSlot(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.Stop(v, stop)
 }
 
+// ObjectSetSlotIfAbsent is an Object method.
+//
+// setSlotIfAbsent sets a slot on this object to the result of evaluating its
+// second argument only if the object does not already have the slot locally,
+// then returns the slot's value. Protos are not checked. The slot is held
+// from the check until the value is set, so when multiple coroutines race to
+// set the same slot, exactly one of them evaluates its value.
+func ObjectSetSlotIfAbsent(vm *VM, target, locals *Object, msg *Message) *Object {
+	slot, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	sy := vm.SetSlotSync(target, slot)
+	defer sy.Unlock()
+	if v := sy.Load(); v != nil {
+		return v
+	}
+	if target.IsFrozen() {
+		return vm.RaiseExceptionf("can't set slot %s on frozen object", slot)
+	}
+	v, stop := msg.EvalArgAt(vm, locals, 1)
+	if stop != NoStop {
+		return vm.Stop(v, stop)
+	}
+	sy.Set(v)
+	return v
+}
+
 // ObjectUpdateSlot is an Object method.
 //
 // updateSlot raises an exception if the target does not have the given slot,
@@ -323,6 +447,9 @@ func ObjectUpdateSlot(vm *VM, target, locals *Object, msg *Message) *Object {
 	if proto == nil {
 		return vm.RaiseExceptionf("slot %s not found", slot)
 	}
+	if target.IsFrozen() {
+		return vm.RaiseExceptionf("can't update slot %s on frozen object", slot)
+	}
 	sy := vm.SetSlotSync(target, slot)
 	v, stop := msg.EvalArgAt(vm, locals, 1)
 	if stop == NoStop {
@@ -672,6 +799,31 @@ func ObjectTry(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.Nil
 }
 
+// ObjectAliasSlot is an Object method.
+//
+// aliasSlot creates a slot named by the first argument which acts as the slot
+// named by the second. The alias looks up the aliased slot each time it is
+// activated, so it stays in sync when that slot is reassigned.
+func ObjectAliasSlot(vm *VM, target, locals *Object, msg *Message) *Object {
+	name, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	existing, exc, stop := msg.StringArgAt(vm, locals, 1)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	if name == existing {
+		return vm.RaiseExceptionf("cannot alias slot %s to itself", name)
+	}
+	if target.IsFrozen() {
+		return vm.RaiseExceptionf("can't set slot %s on frozen object", name)
+	}
+	alias := vm.ObjectWith(nil, []*Object{vm.BaseObject}, existing, SlotAliasTag)
+	vm.SetSlot(target, name, alias)
+	return target
+}
+
 // ObjectAncestorWithSlot is an Object method.
 //
 // ancestorWithSlot returns the proto which owns the given slot.
@@ -778,6 +930,44 @@ func ObjectDoString(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.Stop(m.Eval(vm, target))
 }
 
+// ObjectDoWithLocals is an Object method.
+//
+// doWithLocals evaluates its second argument with the receiver as the target
+// and the first argument as the locals, returning the result. This allows a
+// message to see variables defined on an arbitrary object without adding them
+// to the receiver.
+func ObjectDoWithLocals(vm *VM, target, locals *Object, msg *Message) *Object {
+	l, stop := msg.EvalArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(l, stop)
+	}
+	m := msg.ArgAt(1)
+	if m == nil {
+		return vm.RaiseExceptionf("doWithLocals requires 2 arguments")
+	}
+	return vm.Stop(m.Send(vm, target, l))
+}
+
+// ObjectDocOf is an Object method.
+//
+// docOf returns the documentation for a slot set by setSlotDoc, or nil if
+// there is none. The documentation is taken from the object which actually
+// holds the slot, so docs are inherited along with slots.
+func ObjectDocOf(vm *VM, target, locals *Object, msg *Message) *Object {
+	slot, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	_, proto := vm.GetSlot(target, slot)
+	if proto == nil {
+		proto = target
+	}
+	if doc, ok := proto.SlotDoc(slot); ok {
+		return vm.NewString(doc)
+	}
+	return vm.Nil
+}
+
 // ObjectForeachSlot is a Object method.
 //
 // foreachSlot performs a loop on each slot of an object.
@@ -812,6 +1002,50 @@ func ObjectForeachSlot(vm *VM, target, locals *Object, msg *Message) (result *Ob
 	return result
 }
 
+// ObjectFreeze is an Object method.
+//
+// freeze marks the object so that setSlot, updateSlot, and removeSlot raise an
+// exception when used on it. Freezing is shallow: the object's protos and slot
+// values are unaffected.
+func ObjectFreeze(vm *VM, target, locals *Object, msg *Message) *Object {
+	target.Freeze()
+	return target
+}
+
+// ObjectFreezeDeep is an Object method.
+//
+// freezeDeep freezes the object and every object reachable through its slots.
+// Protos are not frozen, nor are core objects like nil and Core Object.
+func ObjectFreezeDeep(vm *VM, target, locals *Object, msg *Message) *Object {
+	set := contains.Set{}
+	for _, o := range []*Object{vm.Lobby, vm.Core, vm.Addons, vm.BaseObject, vm.True, vm.False, vm.Nil} {
+		set.Add(o.UniqueID())
+	}
+	for _, o := range vm.GetAllSlots(vm.Core) {
+		set.Add(o.UniqueID())
+	}
+	set.Add(target.UniqueID())
+	objs := []*Object{target}
+	for len(objs) > 0 {
+		o := objs[len(objs)-1]
+		objs = objs[:len(objs)-1]
+		o.Freeze()
+		for _, v := range vm.GetAllSlots(o) {
+			if set.Add(v.UniqueID()) {
+				objs = append(objs, v)
+			}
+		}
+	}
+	return target
+}
+
+// ObjectIsFrozen is an Object method.
+//
+// isFrozen returns whether the object has been frozen.
+func ObjectIsFrozen(vm *VM, target, locals *Object, msg *Message) *Object {
+	return vm.IoBool(target.IsFrozen())
+}
+
 // ObjectIsIdenticalTo is an Object method.
 //
 // isIdenticalTo returns whether the object is the same as the argument.
@@ -875,6 +1109,31 @@ func ObjectPerform(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.RaiseExceptionf("argument 0 to perform must be Sequence or Message, not %s", vm.TypeName(r))
 }
 
+// ObjectPerformLater is an Object method.
+//
+// performLater queues the method named by the first argument to be performed
+// on the receiver with the remaining arguments once the current evaluation on
+// this coroutine completes or the coroutine yields or pauses, and returns
+// immediately. The arguments are evaluated now. Deferred messages run in the
+// order they were queued; any exception they raise is passed to System
+// deferredExceptionHandler.
+func ObjectPerformLater(vm *VM, target, locals *Object, msg *Message) *Object {
+	name, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	m := vm.IdentMessage(name)
+	for i := 1; i < msg.ArgCount(); i++ {
+		v, stop := msg.EvalArgAt(vm, locals, i)
+		if stop != NoStop {
+			return vm.Stop(v, stop)
+		}
+		m.Args = append(m.Args, vm.CachedMessage(v))
+	}
+	vm.Defer(target, locals, m)
+	return target
+}
+
 // ObjectPerformWithArgList is an Object method.
 //
 // performWithArgList activates the given method with arguments given in the
@@ -897,6 +1156,86 @@ func ObjectPerformWithArgList(vm *VM, target, locals *Object, msg *Message) *Obj
 	return vm.Stop(vm.Perform(target, locals, m))
 }
 
+// ObjectPerformWithMessageList is an Object method.
+//
+// performWithMessageList activates the given method with the messages in the
+// second argument, a list, as its unevaluated arguments. The method is free to
+// evaluate them in the sender's context as it sees fit.
+func ObjectPerformWithMessageList(vm *VM, target, locals *Object, msg *Message) *Object {
+	name, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	l, obj, stop := msg.ListArgAt(vm, locals, 1)
+	if stop != NoStop {
+		return vm.Stop(obj, stop)
+	}
+	m := vm.IdentMessage(name)
+	obj.Lock()
+	for i, arg := range l {
+		if arg.Tag() != MessageTag {
+			obj.Unlock()
+			return vm.RaiseExceptionf("item %d of argument 1 to performWithMessageList must be Message, not %s", i, vm.TypeName(arg))
+		}
+		m.Args = append(m.Args, arg.Value.(*Message))
+	}
+	obj.Unlock()
+	return vm.Stop(vm.Perform(target, locals, m))
+}
+
+// ObjectPerformWithNamedArgs is an Object method.
+//
+// performWithNamedArgs activates the method named by the first argument with
+// arguments taken from the second, a Map from argument names to values. The
+// method must be a Block. If any of its arguments is missing from the map,
+// an exception is raised. Keys which do not name arguments are ignored, unless
+// the optional third argument is true, in which case they are an error.
+//
+//   io> Object clone do(f := method(a, b, a - b)) performWithNamedArgs("f", Map clone atPut("b", 1) atPut("a", 3))
+//   2
+func ObjectPerformWithNamedArgs(vm *VM, target, locals *Object, msg *Message) *Object {
+	name, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	m, stop := msg.EvalArgAt(vm, locals, 1)
+	if stop != NoStop {
+		return vm.Stop(m, stop)
+	}
+	if m.Tag() != MapTag {
+		return vm.RaiseExceptionf("argument 1 to performWithNamedArgs must be Map, not %s", vm.TypeName(m))
+	}
+	strict := false
+	if msg.ArgCount() > 2 {
+		r, stop := msg.EvalArgAt(vm, locals, 2)
+		if stop != NoStop {
+			return vm.Stop(r, stop)
+		}
+		strict = vm.AsBool(r)
+	}
+	blk, _ := vm.GetSlot(target, name)
+	if blk == nil {
+		return vm.RaiseExceptionf("%s does not respond to %s", vm.TypeName(target), name)
+	}
+	if blk.Tag() != BlockTag {
+		return vm.RaiseExceptionf("%s %s must be Block to take named arguments, not %s", vm.TypeName(target), name, vm.TypeName(blk))
+	}
+	m.Lock()
+	args, missing, extra := blk.Value.(*Block).NamedArgs(m.Value.(map[string]*Object))
+	m.Unlock()
+	if missing != "" {
+		return vm.RaiseExceptionf("missing argument %s to %s", missing, name)
+	}
+	if strict && len(extra) > 0 {
+		return vm.RaiseExceptionf("unknown arguments to %s: %s", name, strings.Join(extra, ", "))
+	}
+	pm := vm.IdentMessage(name)
+	for _, arg := range args {
+		pm.Args = append(pm.Args, vm.CachedMessage(arg))
+	}
+	return vm.Stop(vm.Perform(target, locals, pm))
+}
+
 // ObjectPrependProto is an Object method.
 //
 // prependProto adds a new proto as the first in the object's protos.
@@ -920,9 +1259,9 @@ func ObjectPrint(vm *VM, target, locals *Object, msg *Message) *Object {
 	r.Lock()
 	defer r.Unlock()
 	if r.Tag() == SequenceTag {
-		fmt.Print(r.Value)
+		fmt.Fprint(vm.Stdout, r.Value)
 	} else {
-		fmt.Printf("%v_%p", r.Tag(), r.Value)
+		fmt.Fprintf(vm.Stdout, "%v_%p", r.Tag(), r.Value)
 	}
 	return target
 }
@@ -939,6 +1278,9 @@ func ObjectRemoveAllProtos(vm *VM, target, locals *Object, msg *Message) *Object
 //
 // removeAllSlots removes all slots from the object.
 func ObjectRemoveAllSlots(vm *VM, target, locals *Object, msg *Message) *Object {
+	if target.IsFrozen() {
+		return vm.RaiseExceptionf("can't remove slots from frozen object")
+	}
 	vm.RemoveAllSlots(target)
 	return target
 }
@@ -963,10 +1305,73 @@ func ObjectRemoveSlot(vm *VM, target, locals *Object, msg *Message) *Object {
 	if stop != NoStop {
 		return vm.Stop(exc, stop)
 	}
+	if target.IsFrozen() {
+		return vm.RaiseExceptionf("can't remove slot %s from frozen object", slot)
+	}
 	vm.RemoveSlot(target, slot)
 	return target
 }
 
+// ObjectRemoveSlotEverywhere is an Object method.
+//
+// removeSlotEverywhere removes the given slot from the object and from all of
+// its ancestors. This is dangerous: every other object which inherits from
+// any of those ancestors loses the slot as well. To limit the damage, the
+// shared protos in Core and Addons, and anything beyond them, are left alone
+// unless the optional second argument is true. If any object which would be
+// changed is frozen, then an exception is raised and no slots are removed.
+func ObjectRemoveSlotEverywhere(vm *VM, target, locals *Object, msg *Message) *Object {
+	slot, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	force := false
+	if len(msg.Args) > 1 {
+		r, stop := msg.EvalArgAt(vm, locals, 1)
+		if stop != NoStop {
+			return vm.Stop(r, stop)
+		}
+		force = vm.AsBool(r)
+	}
+	core := contains.Set{}
+	if !force {
+		core.Add(vm.Core.UniqueID())
+		core.Add(vm.Addons.UniqueID())
+		for _, ns := range []*Object{vm.Core, vm.Addons} {
+			for _, p := range vm.GetAllSlots(ns) {
+				if p != nil {
+					core.Add(p.UniqueID())
+				}
+			}
+		}
+	}
+	// As in IsKindOf, the traversal order doesn't matter, so we use our own
+	// set and stack.
+	objs := []*Object{target}
+	protos := []*Object{target}
+	set := contains.Set{}
+	set.Add(target.UniqueID())
+	for len(protos) > 0 {
+		proto := protos[len(protos)-1]
+		protos = protos[:len(protos)-1]
+		for _, p := range proto.Protos() {
+			if !core.Contains(p.UniqueID()) && set.Add(p.UniqueID()) {
+				objs = append(objs, p)
+				protos = append(protos, p)
+			}
+		}
+	}
+	for _, obj := range objs {
+		if obj.IsFrozen() {
+			return vm.RaiseExceptionf("can't remove slot %s from frozen object", slot)
+		}
+	}
+	for _, obj := range objs {
+		vm.RemoveSlot(obj, slot)
+	}
+	return target
+}
+
 // ObjectSetProto is an Object method.
 //
 // setProto sets the object's proto list to have only the given object.
@@ -991,6 +1396,37 @@ func ObjectSetProtos(vm *VM, target, locals *Object, msg *Message) *Object {
 	return target
 }
 
+// ObjectSetSlotDoc is an Object method.
+//
+// setSlotDoc sets human-readable documentation for a slot on this object,
+// which docOf, slotSummary, and apropos report. The slot need not exist yet.
+// If the documentation is nil or empty, any existing documentation is
+// removed.
+func ObjectSetSlotDoc(vm *VM, target, locals *Object, msg *Message) *Object {
+	slot, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	r, stop := msg.EvalArgAt(vm, locals, 1)
+	if stop != NoStop {
+		return vm.Stop(r, stop)
+	}
+	doc := ""
+	if r != vm.Nil {
+		if _, ok := r.Value.(Sequence); !ok {
+			return vm.RaiseExceptionf("argument 1 to setSlotDoc must be Sequence or nil, not %s", vm.TypeName(r))
+		}
+		s := holdSeq(r)
+		doc = s.String()
+		unholdSeq(s.Mutable, r)
+	}
+	if target.IsFrozen() {
+		return vm.RaiseExceptionf("can't set slot doc %s on frozen object", slot)
+	}
+	target.SetSlotDoc(slot, doc)
+	return target
+}
+
 // ObjectShallowCopy is an Object method.
 //
 // shallowCopy creates a new object with the receiver's slots and protos.
@@ -1036,6 +1472,149 @@ func ObjectStopStatus(vm *VM, target, locals *Object, msg *Message) *Object {
 	return r
 }
 
+// ObjectStructurallyEquals is an Object method.
+//
+// structurallyEquals returns whether the receiver and the argument are equal
+// in value, as determined by VM.StructurallyEqual.
+func ObjectStructurallyEquals(vm *VM, target, locals *Object, msg *Message) *Object {
+	other, stop := msg.EvalArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(other, stop)
+	}
+	return vm.IoBool(vm.StructurallyEqual(target, other))
+}
+
+// StructurallyEqual returns whether x and y are equal in value. Both must have
+// the same tag and structurally equal local slots with the same names. Beyond
+// that, Numbers are equal if their values are, Sequences if their elements
+// are, and Lists and Maps if their items are structurally equal. Other objects
+// must have identical protos in the same order and no Go values. Cycles are
+// handled by assuming objects which are already being compared are equal.
+func (vm *VM) StructurallyEqual(x, y *Object) bool {
+	return vm.structurallyEqual(x, y, map[[2]*Object]bool{})
+}
+
+func (vm *VM) structurallyEqual(x, y *Object, seen map[[2]*Object]bool) bool {
+	if x == y {
+		return true
+	}
+	if x.Tag() != y.Tag() {
+		return false
+	}
+	pair := [2]*Object{x, y}
+	if seen[pair] {
+		return true
+	}
+	seen[pair] = true
+	x.Lock()
+	xv := snapshotValue(x.Value)
+	x.Unlock()
+	y.Lock()
+	yv := snapshotValue(y.Value)
+	y.Unlock()
+	switch a := xv.(type) {
+	case nil:
+		if yv != nil {
+			return false
+		}
+		xp, yp := x.Protos(), y.Protos()
+		if len(xp) != len(yp) {
+			return false
+		}
+		for i, p := range xp {
+			if p != yp[i] {
+				return false
+			}
+		}
+	case float64:
+		if a != yv.(float64) {
+			return false
+		}
+	case Sequence:
+		if a.Compare(yv.(Sequence)) != 0 {
+			return false
+		}
+	case []*Object:
+		b := yv.([]*Object)
+		if len(a) != len(b) {
+			return false
+		}
+		for i, v := range a {
+			if !vm.structurallyEqual(v, b[i], seen) {
+				return false
+			}
+		}
+	case map[string]*Object:
+		b := yv.(map[string]*Object)
+		if len(a) != len(b) {
+			return false
+		}
+		for k, v := range a {
+			w, ok := b[k]
+			if !ok || !vm.structurallyEqual(v, w, seen) {
+				return false
+			}
+		}
+	default:
+		return false
+	}
+	xs, ys := vm.GetAllSlots(x), vm.GetAllSlots(y)
+	if len(xs) != len(ys) {
+		return false
+	}
+	for k, v := range xs {
+		w, ok := ys[k]
+		if !ok || !vm.structurallyEqual(v, w, seen) {
+			return false
+		}
+	}
+	return true
+}
+
+// snapshotValue copies the parts of an object value that StructurallyEqual
+// compares, so that they can be examined without holding the object's lock.
+func snapshotValue(v interface{}) interface{} {
+	switch a := v.(type) {
+	case Sequence:
+		sv := reflect.ValueOf(a.Value)
+		c := reflect.MakeSlice(sv.Type(), sv.Len(), sv.Len())
+		reflect.Copy(c, sv)
+		return Sequence{Value: c.Interface(), Mutable: a.Mutable, Code: a.Code}
+	case []*Object:
+		return append([]*Object(nil), a...)
+	case map[string]*Object:
+		m := make(map[string]*Object, len(a))
+		for k, v := range a {
+			m[k] = v
+		}
+		return m
+	}
+	return v
+}
+
+// ObjectTap is an Object method.
+//
+// tap calls the block argument with the receiver as its argument, then returns
+// the receiver, ignoring the block's result. This allows inserting side
+// effects like logging into a chain of messages without breaking it, e.g.
+// x foo tap(block(v, v println)) bar. If the block is a method, the receiver
+// is also its self. Control flow from the block propagates.
+func ObjectTap(vm *VM, target, locals *Object, msg *Message) *Object {
+	blk, stop := msg.EvalArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(blk, stop)
+	}
+	if blk.Tag() != BlockTag {
+		return vm.RaiseExceptionf("argument 0 to tap must be Block, not %s", vm.TypeName(blk))
+	}
+	m := vm.IdentMessage("", vm.CachedMessage(target))
+	r := vm.ActivateBlock(blk, target, locals, locals, m)
+	if r, stop := vm.Status(r); stop != NoStop {
+		return vm.Stop(r, stop)
+	}
+	return target
+}
+
 // ObjectThisContext is an Object method.
 //
 // thisContext returns the current slot context, which is the receiver.
@@ -1,6 +1,7 @@
 package internal_test
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/zephyrtronium/iolang"
@@ -100,6 +101,86 @@ func TestObjectGoActivate(t *testing.T) {
 	vm.RemoveSlot(vm.Lobby, "TestObjectActivate")
 }
 
+// TestObjectPerformLater tests that performLater defers messages until the
+// current evaluation completes and runs them in order.
+func TestObjectPerformLater(t *testing.T) {
+	vm := testutils.VM()
+	l := vm.NewList()
+	vm.SetSlot(vm.Lobby, "TestObjectPerformLater", l)
+	r, stop := vm.DoString(`TestObjectPerformLater do(performLater("append", 1); performLater("append", 2); append(0)) size`, "TestObjectPerformLater")
+	if !testutils.PassEqual(vm.NewNumber(1))(r, stop) {
+		t.Errorf("wrong size during evaluation: want 1, got %v", vm.AsString(r))
+	}
+	want := vm.NewList(vm.NewNumber(0), vm.NewNumber(1), vm.NewNumber(2))
+	if !testutils.PassEqual(want)(l, iolang.NoStop) {
+		t.Errorf("wrong result after deferred messages: want %v, got %v", vm.AsString(want), vm.AsString(l))
+	}
+	vm.RemoveSlot(vm.Lobby, "TestObjectPerformLater")
+}
+
+// TestObjectPerformLaterNested tests that a nested DoString does not run
+// messages deferred by the evaluation that contains it.
+func TestObjectPerformLaterNested(t *testing.T) {
+	vm := testutils.VM()
+	l := vm.NewList()
+	vm.SetSlot(vm.Lobby, "TestObjectPerformLaterNested", l)
+	nested := func(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+		return vm.Stop(vm.DoString(`nil`, "TestObjectPerformLaterNested"))
+	}
+	vm.SetSlot(l, "nested", vm.NewCFunction(nested, nil))
+	r, stop := vm.DoString(`TestObjectPerformLaterNested do(performLater("append", 1); nested; append(0)) size`, "TestObjectPerformLaterNested")
+	if !testutils.PassEqual(vm.NewNumber(1))(r, stop) {
+		t.Errorf("wrong size during evaluation: want 1, got %v", vm.AsString(r))
+	}
+	want := vm.NewList(vm.NewNumber(0), vm.NewNumber(1))
+	if !testutils.PassEqual(want)(l, iolang.NoStop) {
+		t.Errorf("wrong result after deferred messages: want %v, got %v", vm.AsString(want), vm.AsString(l))
+	}
+	vm.RemoveSlot(vm.Lobby, "TestObjectPerformLaterNested")
+}
+
+// TestObjectSetSlotIfAbsentRace tests that when two coroutines race to
+// setSlotIfAbsent the same slot, exactly one of them sets it, and both see the
+// winner's value.
+func TestObjectSetSlotIfAbsentRace(t *testing.T) {
+	vm := testutils.VM()
+	for i := 0; i < 100; i++ {
+		obj := vm.NewObject(nil)
+		start := make(chan struct{})
+		var wg sync.WaitGroup
+		values := [2]*iolang.Object{vm.NewObject(nil), vm.NewObject(nil)}
+		var results [2]*iolang.Object
+		wg.Add(len(values))
+		for k := range values {
+			coro := vm.VMFor(vm.Coro.Clone())
+			go func(k int) {
+				defer wg.Done()
+				m := coro.IdentMessage("setSlotIfAbsent", coro.CachedMessage(coro.NewString("x")), coro.CachedMessage(values[k]))
+				<-start
+				results[k], _ = coro.Perform(obj, obj, m)
+			}(k)
+		}
+		close(start)
+		wg.Wait()
+		x, ok := vm.GetLocalSlot(obj, "x")
+		if !ok {
+			t.Fatal("slot not set")
+		}
+		winners := 0
+		for k, v := range values {
+			if v == x {
+				winners++
+			}
+			if results[k] != x {
+				t.Errorf("coroutine %d got %v, not the slot's value %v", k, results[k], x)
+			}
+		}
+		if winners != 1 {
+			t.Fatalf("%d winners", winners)
+		}
+	}
+}
+
 // TestObjectSlots tests that a new VM Object has the slots we expect.
 func TestObjectSlots(t *testing.T) {
 	slots := []string{
@@ -116,6 +197,7 @@ func TestObjectSlots(t *testing.T) {
 		// "actorProcessQueue",
 		// "actorRun",
 		"addTrait",
+		"aliasSlot",
 		"ancestorWithSlot",
 		"ancestors",
 		"and",
@@ -127,6 +209,7 @@ func TestObjectSlots(t *testing.T) {
 		"asString",
 		"block",
 		"break",
+		"callStack",
 		"clone",
 		"cloneWithoutInit",
 		"compare",
@@ -138,11 +221,15 @@ func TestObjectSlots(t *testing.T) {
 		"doMessage",
 		"doRelativeFile",
 		"doString",
+		"doWithLocals",
+		"docOf",
 		"evalArg",
 		"evalArgAndReturnNil",
 		"evalArgAndReturnSelf",
 		"for",
 		"foreachSlot",
+		"freeze",
+		"freezeDeep",
 		"getLocalSlot",
 		"getSlot",
 		// "handleActorException",
@@ -160,6 +247,7 @@ func TestObjectSlots(t *testing.T) {
 		"inlineMethod",
 		"isActivatable",
 		"isError",
+		"isFrozen",
 		"isIdenticalTo",
 		"isKindOf",
 		"isLaunchScript",
@@ -178,7 +266,10 @@ func TestObjectSlots(t *testing.T) {
 		"not",
 		"or",
 		"perform",
+		"performLater",
 		"performWithArgList",
+		"performWithMessageList",
+		"performWithNamedArgs",
 		"prependProto",
 		"print",
 		"println",
@@ -190,6 +281,7 @@ func TestObjectSlots(t *testing.T) {
 		"removeAllSlots",
 		"removeProto",
 		"removeSlot",
+		"removeSlotEverywhere",
 		"resend",
 		"return",
 		"returnIfError",
@@ -201,6 +293,8 @@ func TestObjectSlots(t *testing.T) {
 		"setProto",
 		"setProtos",
 		"setSlot",
+		"setSlotDoc",
+		"setSlotIfAbsent",
 		"setSlotWithType",
 		"shallowCopy",
 		"slotDescriptionMap",
@@ -208,8 +302,10 @@ func TestObjectSlots(t *testing.T) {
 		"slotSummary",
 		"slotValues",
 		"stopStatus",
+		"structurallyEquals",
 		"super",
 		"switch",
+		"tap",
 		"thisContext",
 		"thisLocalContext",
 		"thisMessage",
@@ -338,6 +434,17 @@ func TestObjectMethods(t *testing.T) {
 			"badres": {Source: `Object clone do(x := 4) addTrait(testValues obj, Map clone do(atPut("x", 1)))`, Pass: testutils.PassFailure()},
 			"short":  {Source: `Object clone addTrait`, Pass: testutils.PassFailure()},
 		},
+		"aliasSlot": {
+			"alias":     {Source: `testValues aliasObj := Object clone do(a := 1; aliasSlot("b", "a")); testValues aliasObj b`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"sync":      {Source: `testValues aliasObj := Object clone do(a := 1; aliasSlot("b", "a"); a = 2); testValues aliasObj b`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"method":    {Source: `testValues aliasObj := Object clone do(a := method(x, x + 1); aliasSlot("b", "a")); testValues aliasObj b(2)`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+			"inherited": {Source: `testValues aliasObj := Object clone do(a := 1; aliasSlot("b", "a")); testValues aliasObj clone do(a := 3) b`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+			"missing":   {Source: `testValues aliasObj := Object clone do(aliasSlot("b", "a")); testValues aliasObj b`, Pass: testutils.PassFailure()},
+			"self":      {Source: `Object clone aliasSlot("a", "a")`, Pass: testutils.PassFailure()},
+			"bad":       {Source: `Object clone aliasSlot("b", 1)`, Pass: testutils.PassFailure()},
+			"continue":  {Source: `Object clone aliasSlot(continue, "a")`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+			"exception": {Source: `Object clone aliasSlot(Exception raise, "a")`, Pass: testutils.PassFailure()},
+		},
 		"ancestorWithSlot": {
 			"local":         {Source: `Number ancestorWithSlot("abs")`, Pass: testutils.PassIdentical(vm.Nil)},
 			"proto":         {Source: `Lobby clone ancestorWithSlot("Lobby")`, Pass: testutils.PassIdentical(vm.Lobby)},
@@ -431,6 +538,14 @@ func TestObjectMethods(t *testing.T) {
 			"label":    {Source: `testValues doStringLabel := "foo"; testValues doString("doStringLabel = thisMessage label", "bar"); testValues doStringLabel`, Pass: testutils.PassEqual(vm.NewString("bar"))},
 			"bad":      {Source: `testValues doString(message(doStringValue := 4))`, Pass: testutils.PassFailure()},
 		},
+		"docOf": {
+			"local":        {Source: `Object clone do(x := 1) setSlotDoc("x", "an x") docOf("x")`, Pass: testutils.PassEqual(vm.NewString("an x"))},
+			"inherited":    {Source: `Object clone do(x := 1) setSlotDoc("x", "an x") clone docOf("x")`, Pass: testutils.PassEqual(vm.NewString("an x"))},
+			"override":     {Source: `Object clone do(x := 1) setSlotDoc("x", "an x") clone do(x := 2) docOf("x")`, Pass: testutils.PassIdentical(vm.Nil)},
+			"undocumented": {Source: `Object clone do(x := 1) docOf("x")`, Pass: testutils.PassIdentical(vm.Nil)},
+			"noSlot":       {Source: `Object clone setSlotDoc("x", "an x") docOf("x")`, Pass: testutils.PassEqual(vm.NewString("an x"))},
+			"summary":      {Source: `Object clone do(x := 1) setSlotDoc("x", "an x") slotSummary containsSeq("an x")`, Pass: testutils.PassIdentical(vm.True)},
+		},
 		"evalArgAndReturnNil": {
 			"result":    {Source: `evalArgAndReturnNil(Lobby)`, Pass: testutils.PassIdentical(vm.Nil)},
 			"eval":      {Source: `testValues evalNil := 0; evalArgAndReturnNil(testValues evalNil := 1); testValues evalNil`, Pass: testutils.PassEqual(vm.NewNumber(1))},
@@ -518,23 +633,33 @@ func TestObjectMethods(t *testing.T) {
 			"self":   {Source: `ifError(nil)`, Pass: testutils.PassIdentical(vm.Lobby)},
 		},
 		"ifNil": {
-			"noEval": {Source: `testValues ifNilResult := false; ifNil(testValues ifNilResult := true); testValues ifNilResult`, Pass: testutils.PassIdentical(vm.False)},
-			"self":   {Source: `ifNil(nil)`, Pass: testutils.PassIdentical(vm.Lobby)},
+			"noEval":  {Source: `testValues ifNilResult := false; ifNil(testValues ifNilResult := true); testValues ifNilResult`, Pass: testutils.PassIdentical(vm.False)},
+			"self":    {Source: `ifNil(nil)`, Pass: testutils.PassIdentical(vm.Lobby)},
+			"nil":     {Source: `nil ifNil(1)`, Pass: testutils.PassIdentical(vm.Nil)},
+			"nilEval": {Source: `testValues ifNilResult := false; nil ifNil(testValues ifNilResult := true); testValues ifNilResult`, Pass: testutils.PassIdentical(vm.True)},
 		},
 		"ifNilEval": {
-			"noEval": {Source: `testValues ifNilEvalResult := false; ifNilEval(testValues ifNilEvalResult := true); testValues ifNilEvalResult`, Pass: testutils.PassIdentical(vm.False)},
-			"self":   {Source: `ifNilEval(nil)`, Pass: testutils.PassIdentical(vm.Lobby)},
+			"noEval":      {Source: `testValues ifNilEvalResult := false; ifNilEval(testValues ifNilEvalResult := true); testValues ifNilEvalResult`, Pass: testutils.PassIdentical(vm.False)},
+			"self":        {Source: `ifNilEval(nil)`, Pass: testutils.PassIdentical(vm.Lobby)},
+			"value":       {Source: `1 ifNilEval(2)`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"nil":         {Source: `nil ifNilEval(2)`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"nilContinue": {Source: `nil ifNilEval(continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
 		},
 		"ifNonNil": {
 			"result":    {Source: `ifNonNil(nil)`, Pass: testutils.PassIdentical(vm.Lobby)},
 			"eval":      {Source: `testValues ifNonNilResult := 0; ifNonNil(testValues ifNonNilResult := 1); testValues ifNonNilResult`, Pass: testutils.PassEqual(vm.NewNumber(1))},
 			"continue":  {Source: `ifNonNil(continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
 			"exception": {Source: `ifNonNil(Exception raise)`, Pass: testutils.PassFailure()},
+			"nil":       {Source: `nil ifNonNil(1)`, Pass: testutils.PassIdentical(vm.Nil)},
+			"nilNoEval": {Source: `testValues ifNonNilResult := false; nil ifNonNil(testValues ifNonNilResult := true); testValues ifNonNilResult`, Pass: testutils.PassIdentical(vm.False)},
 		},
 		"ifNonNilEval": {
 			"evalArg":   {Source: `ifNonNilEval(Lobby)`, Pass: testutils.PassEqual(vm.Lobby)},
 			"continue":  {Source: `ifNonNilEval(continue; Lobby)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
 			"exception": {Source: `ifNonNilEval(Exception raise; Lobby)`, Pass: testutils.PassFailure()},
+			"value":     {Source: `1 ifNonNilEval(2)`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"nil":       {Source: `nil ifNonNilEval(2)`, Pass: testutils.PassIdentical(vm.Nil)},
+			"nilNoEval": {Source: `testValues ifNonNilEvalResult := false; nil ifNonNilEval(testValues ifNonNilEvalResult := true); testValues ifNonNilEvalResult`, Pass: testutils.PassIdentical(vm.False)},
 		},
 		"in": {
 			"contains": {Source: `testValues contains := method(self inResult := true); Object in(testValues); testValues inResult`, Pass: testutils.PassIdentical(vm.True)},
@@ -653,6 +778,27 @@ func TestObjectMethods(t *testing.T) {
 			"continue":  {Source: `testValues performWithArgList(continue, list)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
 			"exception": {Source: `testValues performWithArgList(Exception raise, list)`, Pass: testutils.PassFailure()},
 		},
+		"performWithMessageList": {
+			"perform":   {Source: `testValues performWithMessageList("if", list(message(true), message(1), message(Exception raise)))`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"lazy":      {Source: `testValues performWithMessageList("if", list(message(false), message(Exception raise), message(2)))`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"wrong":     {Source: `testValues performWithMessageList("if", list(true, 1, 2))`, Pass: testutils.PassFailure()},
+			"continue":  {Source: `testValues performWithMessageList(continue, list)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+			"exception": {Source: `testValues performWithMessageList(Exception raise, list)`, Pass: testutils.PassFailure()},
+		},
+		"performWithNamedArgs": {
+			"perform":   {Source: `Object clone do(f := method(a, b, a - b)) performWithNamedArgs("f", Map clone atPut("b", 1) atPut("a", 3))`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"none":      {Source: `Object clone do(f := method(1)) performWithNamedArgs("f", Map clone)`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"self":      {Source: `Object clone do(x := 4; f := method(a, x * a)) performWithNamedArgs("f", Map clone atPut("a", 2))`, Pass: testutils.PassEqual(vm.NewNumber(8))},
+			"missing":   {Source: `Object clone do(f := method(a, b, a - b)) performWithNamedArgs("f", Map clone atPut("a", 3))`, Pass: testutils.PassFailure()},
+			"extra":     {Source: `Object clone do(f := method(a, a)) performWithNamedArgs("f", Map clone atPut("a", 3) atPut("z", 0))`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+			"strict":    {Source: `Object clone do(f := method(a, a)) performWithNamedArgs("f", Map clone atPut("a", 3) atPut("z", 0), true)`, Pass: testutils.PassFailure()},
+			"strictOk":  {Source: `Object clone do(f := method(a, a)) performWithNamedArgs("f", Map clone atPut("a", 3), true)`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+			"noSlot":    {Source: `Object clone performWithNamedArgs("f", Map clone)`, Pass: testutils.PassFailure()},
+			"notBlock":  {Source: `Object clone do(f := 1) performWithNamedArgs("f", Map clone)`, Pass: testutils.PassFailure()},
+			"notMap":    {Source: `Object clone do(f := method(1)) performWithNamedArgs("f", list)`, Pass: testutils.PassFailure()},
+			"continue":  {Source: `testValues performWithNamedArgs(continue, Map clone)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+			"exception": {Source: `Object clone do(f := method(a, Exception raise)) performWithNamedArgs("f", Map clone atPut("a", 1))`, Pass: testutils.PassFailure()},
+		},
 		"prependProto": {
 			"none":      {Source: `testValues prependProtoObj := Object clone removeAllProtos; Object getSlot("prependProto") performOn(testValues prependProtoObj, thisLocalContext, message(prependProto(Lobby))); Object getSlot("protos") performOn(testValues prependProtoObj)`, Pass: testutils.PassEqual(vm.NewList(vm.Lobby))},
 			"one":       {Source: `testValues prependProtoObj := Object clone; testValues prependProtoObj prependProto(Lobby); testValues prependProtoObj protos`, Pass: testutils.PassEqual(vm.NewList(vm.Lobby, vm.BaseObject))},
@@ -698,6 +844,62 @@ func TestObjectMethods(t *testing.T) {
 			"one":       {Source: `testValues slotsObj := Object clone do(x := 0); testValues slotsObj clone do(x := 1) removeSlot("y") x`, Pass: testutils.PassEqual(vm.NewNumber(1))},
 			"oneRemove": {Source: `testValues slotsObj := Object clone do(x := 0); testValues slotsObj clone do(x := 1) removeSlot("x") x`, Pass: testutils.PassEqual(vm.NewNumber(0))},
 		},
+		"removeSlotEverywhere": {
+			"none":     {Source: `Object clone removeSlotEverywhere("nothing")`, Pass: testutils.PassSuccess()},
+			"protos":   {Source: `Object clone do(a := Object clone do(x := 0); b := a clone do(x := 1); c := b clone do(x := 2) removeSlotEverywhere("x"); r := list(a, b, c) map(hasLocalSlot("x"))) r`, Pass: testutils.PassEqual(vm.NewList(vm.False, vm.False, vm.False))},
+			"multiple": {Source: `Object clone do(a := Object clone do(x := 0); b := Object clone do(x := 1); c := a clone appendProto(b) removeSlotEverywhere("x"); r := list(a, b) map(hasLocalSlot("x"))) r`, Pass: testutils.PassEqual(vm.NewList(vm.False, vm.False))},
+			"others":   {Source: `Object clone do(a := Object clone do(x := 0; y := 1); a clone removeSlotEverywhere("x"); r := a hasLocalSlot("y")) r`, Pass: testutils.PassIdentical(vm.True)},
+			"core":     {Source: `Object clone removeSlotEverywhere("clone"); Object hasLocalSlot("clone")`, Pass: testutils.PassIdentical(vm.True)},
+			"coreDeep": {Source: `Object clone do(a := Object clone do(x := 0); c := a clone removeSlotEverywhere("isNil"); r := Object hasLocalSlot("isNil")) r`, Pass: testutils.PassIdentical(vm.True)},
+			"force":    {Source: `Number removeSlotEverywhereTest := 0; 1 clone removeSlotEverywhere("removeSlotEverywhereTest", true); Number hasLocalSlot("removeSlotEverywhereTest")`, Pass: testutils.PassIdentical(vm.False)},
+			"frozen":   {Source: `Object clone do(a := Object clone do(x := 0) freeze; b := a clone do(x := 1); e := try(b removeSlotEverywhere("x")); r := list(e isNil, b hasLocalSlot("x"))) r`, Pass: testutils.PassEqual(vm.NewList(vm.False, vm.True))},
+			"badName":  {Source: `Object clone removeSlotEverywhere(1)`, Pass: testutils.PassFailure()},
+		},
+		"setSlotDoc": {
+			"remove":    {Source: `Object clone setSlotDoc("x", "an x") setSlotDoc("x", nil) docOf("x")`, Pass: testutils.PassIdentical(vm.Nil)},
+			"empty":     {Source: `Object clone setSlotDoc("x", "an x") setSlotDoc("x", "") docOf("x")`, Pass: testutils.PassIdentical(vm.Nil)},
+			"mutable":   {Source: `Object clone setSlotDoc("x", "an x" asMutable) docOf("x")`, Pass: testutils.PassEqual(vm.NewString("an x"))},
+			"notString": {Source: `Object clone setSlotDoc("x", 1)`, Pass: testutils.PassFailure()},
+			"frozen":    {Source: `Object clone freeze setSlotDoc("x", "an x")`, Pass: testutils.PassFailure()},
+			"continue":  {Source: `Object clone setSlotDoc("x", continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+		},
+		"setSlotIfAbsent": {
+			"absent":    {Source: `Object clone setSlotIfAbsent("x", 1)`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"set":       {Source: `Object clone do(setSlotIfAbsent("x", 1)) x`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"present":   {Source: `Object clone do(x := 0) setSlotIfAbsent("x", 1)`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"lazy":      {Source: `Object clone do(x := 0; setSlotIfAbsent("x", Exception raise)) x`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"local":     {Source: `Object clone do(x := 0) clone do(setSlotIfAbsent("x", 1)) x`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"noSetter":  {Source: `Object clone do(setSlotIfAbsent("x", 1)) hasLocalSlot("setX")`, Pass: testutils.PassIdentical(vm.False)},
+			"frozen":    {Source: `Object clone freeze setSlotIfAbsent("x", 1)`, Pass: testutils.PassFailure()},
+			"exception": {Source: `Object clone setSlotIfAbsent("x", Exception raise)`, Pass: testutils.PassFailure()},
+			"continue":  {Source: `Object clone setSlotIfAbsent("x", continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+		},
+		"structurallyEquals": {
+			"identical":  {Source: `testValues structurallyEquals(testValues)`, Pass: testutils.PassIdentical(vm.True)},
+			"numbers":    {Source: `1 structurallyEquals(1)`, Pass: testutils.PassIdentical(vm.True)},
+			"sequences":  {Source: `"abc" structurallyEquals("abc" asMutable)`, Pass: testutils.PassIdentical(vm.True)},
+			"lists":      {Source: `list(1, list("a")) structurallyEquals(list(1, list("a")))`, Pass: testutils.PassIdentical(vm.True)},
+			"listsDiff":  {Source: `list(1, 2) structurallyEquals(list(1, 3))`, Pass: testutils.PassIdentical(vm.False)},
+			"maps":       {Source: `Map clone atPut("a", list(1)) structurallyEquals(Map clone atPut("a", list(1)))`, Pass: testutils.PassIdentical(vm.True)},
+			"objects":    {Source: `Object clone do(a := 1; b := "x") structurallyEquals(Object clone do(b := "x"; a := 1))`, Pass: testutils.PassIdentical(vm.True)},
+			"nested":     {Source: `Object clone do(a := Object clone do(b := list(1))) structurallyEquals(Object clone do(a := Object clone do(b := list(1))))`, Pass: testutils.PassIdentical(vm.True)},
+			"nestedDiff": {Source: `Object clone do(a := Object clone do(b := 1)) structurallyEquals(Object clone do(a := Object clone do(b := 2)))`, Pass: testutils.PassIdentical(vm.False)},
+			"extraSlot":  {Source: `Object clone do(a := 1) structurallyEquals(Object clone do(a := 1; b := 2))`, Pass: testutils.PassIdentical(vm.False)},
+			"protos":     {Source: `Object clone do(a := 1) structurallyEquals(Object clone clone do(a := 1))`, Pass: testutils.PassIdentical(vm.False)},
+			"types":      {Source: `1 structurallyEquals("1")`, Pass: testutils.PassIdentical(vm.False)},
+			"cycle":      {Source: `Object clone do(me := thisContext) structurallyEquals(Object clone do(me := thisContext))`, Pass: testutils.PassIdentical(vm.True)},
+			"mutual":     {Source: `Object clone do(a := Object clone; a b := thisContext) structurallyEquals(Object clone do(a := Object clone; a b := thisContext))`, Pass: testutils.PassIdentical(vm.True)},
+			"cycleDiff":  {Source: `Object clone do(me := thisContext; x := 1) structurallyEquals(Object clone do(me := thisContext; x := 2))`, Pass: testutils.PassIdentical(vm.False)},
+			"listCycle":  {Source: `list do(append(thisContext)) structurallyEquals(list do(append(thisContext)))`, Pass: testutils.PassIdentical(vm.True)},
+		},
+		"tap": {
+			"result":    {Source: `testValues tap(block(v, 1)) isIdenticalTo(testValues)`, Pass: testutils.PassIdentical(vm.True)},
+			"argument":  {Source: `testValues tapObj := Object clone; testValues tapObj tap(block(v, v x := 1)) x`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"method":    {Source: `testValues tapObj := Object clone; testValues tapObj tap(method(self x := 2)) x`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"notBlock":  {Source: `testValues tap(1)`, Pass: testutils.PassFailure()},
+			"continue":  {Source: `testValues tap(continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+			"exception": {Source: `testValues tap(block(Exception raise))`, Pass: testutils.PassFailure()},
+		},
 	}
 	for name, c := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"bufio"
+	"io"
+	"sync"
+)
+
+// Output is a writer for a VM's standard output, shared by all coroutines in
+// the VM. It writes through to its underlying writer by default, but it can
+// be set to buffer writes, which is much faster for programs that print many
+// small pieces of text.
+type Output struct {
+	mu  sync.Mutex
+	w   io.Writer
+	buf *bufio.Writer
+}
+
+// NewOutput creates a new unbuffered Output writing to w.
+func NewOutput(w io.Writer) *Output {
+	return &Output{w: w}
+}
+
+// Write writes p to the output.
+func (o *Output) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.buf != nil {
+		return o.buf.Write(p)
+	}
+	return o.w.Write(p)
+}
+
+// Flush writes any buffered data to the underlying writer. It is safe to call
+// if the output is unbuffered.
+func (o *Output) Flush() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.buf != nil {
+		return o.buf.Flush()
+	}
+	return nil
+}
+
+// SetBuffered sets whether the output is buffered. Switching from buffered to
+// unbuffered flushes any pending data.
+func (o *Output) SetBuffered(buffered bool) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if buffered {
+		if o.buf == nil {
+			o.buf = bufio.NewWriter(o.w)
+		}
+		return nil
+	}
+	if o.buf != nil {
+		err := o.buf.Flush()
+		o.buf = nil
+		return err
+	}
+	return nil
+}
+
+// SetWriter changes the output's underlying writer. Any data buffered for the
+// old writer is flushed to it first.
+func (o *Output) SetWriter(w io.Writer) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.buf != nil {
+		err := o.buf.Flush()
+		o.buf = bufio.NewWriter(w)
+		o.w = w
+		return err
+	}
+	o.w = w
+	return nil
+}
+
+// IsBuffered returns whether the output is buffered.
+func (o *Output) IsBuffered() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.buf != nil
+}
@@ -0,0 +1,41 @@
+package internal_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/zephyrtronium/iolang"
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+// BenchmarkPrintln benchmarks printing 100k lines with and without output
+// buffering.
+func BenchmarkPrintln(b *testing.B) {
+	vm := testutils.VM()
+	null, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		b.Skip("can't open", os.DevNull, err)
+	}
+	defer null.Close()
+	old := vm.Stdout
+	defer func() { vm.Stdout = old }()
+	msg, err := vm.Parse(strings.NewReader(`for(i, 1, 100000, "line" println)`), "BenchmarkPrintln")
+	if err != nil {
+		b.Fatal(err)
+	}
+	cases := map[string]bool{
+		"Unbuffered": false,
+		"Buffered":   true,
+	}
+	for name, c := range cases {
+		b.Run(name, func(b *testing.B) {
+			vm.Stdout = iolang.NewOutput(null)
+			vm.Stdout.SetBuffered(c)
+			for i := 0; i < b.N; i++ {
+				BenchDummy, _ = vm.DoMessage(msg, vm.Lobby)
+			}
+			vm.Stdout.Flush()
+		})
+	}
+}
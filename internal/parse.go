@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"os"
 	"strconv"
 	"strings"
 )
@@ -229,13 +230,32 @@ func (vm *VM) DoString(src string, label string) (*Object, Stop) {
 	return vm.DoReader(strings.NewReader(src), label)
 }
 
-// DoReader parses and executes an io.Reader.
+// DoReader parses and executes an io.Reader, then runs any messages deferred
+// with performLater if this is not nested within another DoReader or RunFile.
 func (vm *VM) DoReader(src io.Reader, label string) (*Object, Stop) {
 	msg, err := vm.Parse(src, label)
 	if err != nil {
-		return vm.IoError(err), ExceptionStop
+		return vm.NewException(err), ExceptionStop
 	}
-	return vm.DoMessage(msg, vm.Lobby)
+	return vm.doTopLevel(msg)
+}
+
+// RunFile parses and executes the Io source file at path in the Lobby, first
+// setting System launchScript to the path, then runs any messages deferred
+// with performLater as DoReader does. If the file cannot be opened or parsed, the result is an
+// exception.
+func (vm *VM) RunFile(path string) (*Object, Stop) {
+	f, err := os.Open(path)
+	if err != nil {
+		return vm.NewException(err), ExceptionStop
+	}
+	defer f.Close()
+	vm.SetLaunchScript(path)
+	msg, err := vm.Parse(f, path)
+	if err != nil {
+		return vm.NewException(err), ExceptionStop
+	}
+	return vm.doTopLevel(msg)
 }
 
 // DoMessage evaluates a message.
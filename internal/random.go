@@ -0,0 +1,147 @@
+package internal
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// randSource is a random number generator shared by all coroutines in a VM,
+// used by built-in methods which accept an optional generator when none is
+// given.
+type randSource struct {
+	mu sync.Mutex
+	r  *rand.Rand
+}
+
+// newRandSource creates a randSource seeded from the system's secure random
+// source, or from the current time if that fails.
+func newRandSource() *randSource {
+	var b [8]byte
+	seed := time.Now().UnixNano()
+	if _, err := crand.Read(b[:]); err == nil {
+		seed = int64(binary.LittleEndian.Uint64(b[:]))
+	}
+	return &randSource{r: rand.New(rand.NewSource(seed))}
+}
+
+// Float64 returns a uniform draw in [0, 1).
+func (s *randSource) Float64() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.r.Float64()
+}
+
+// Intn returns a uniform draw in [0, n). Panics if n <= 0.
+func (s *randSource) Intn(n int) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.r.Intn(n)
+}
+
+// Seed reseeds the source.
+func (s *randSource) Seed(seed int64) {
+	s.mu.Lock()
+	s.r.Seed(seed)
+	s.mu.Unlock()
+}
+
+// SetRandomSeed reseeds the VM's global random source, which is shared by all
+// coroutines, making the built-in methods that draw from it deterministic.
+// Those are the methods of the Random proto itself, Sequence and List shuffle,
+// Sequence sample, List randomElement, and the methods which take an optional
+// random generator when none is given: currently Sequence sampleIndex.
+func (vm *VM) SetRandomSeed(seed int64) {
+	vm.rand.Seed(seed)
+}
+
+// tagRandom is the Tag type for Random objects.
+type tagRandom struct{}
+
+func (tagRandom) Activate(vm *VM, self, target, locals, context *Object, msg *Message) *Object {
+	return self
+}
+
+// CloneValue creates a new, independently seeded source, so that seeding a
+// clone of Random does not affect the VM's global source.
+func (tagRandom) CloneValue(value interface{}) interface{} {
+	return newRandSource()
+}
+
+func (tagRandom) String() string {
+	return "Random"
+}
+
+// RandomTag is the Tag for Random objects. Activate returns self. CloneValue
+// creates a new random source.
+var RandomTag tagRandom
+
+// initRandom initializes Random on this VM. The Random proto draws from the
+// VM's global random source.
+func (vm *VM) initRandom() {
+	slots := Slots{
+		"integer":      vm.NewCFunction(RandomInteger, RandomTag),
+		"setSeed":      vm.NewCFunction(RandomSetSeed, RandomTag),
+		"type":         vm.NewString("Random"),
+		"value":        vm.NewCFunction(RandomValue, RandomTag),
+		"valueBetween": vm.NewCFunction(RandomValueBetween, RandomTag),
+	}
+	vm.coreInstall("Random", slots, vm.rand, RandomTag)
+}
+
+// RandomInteger is a Random method.
+//
+// integer returns a uniformly chosen integer in [0, n) for a positive integer
+// argument n.
+//
+//   io> Random clone setSeed(1) integer(6)
+//   5
+func RandomInteger(vm *VM, target, locals *Object, msg *Message) *Object {
+	n, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	if n < 1 || n != math.Trunc(n) || n > math.MaxInt32 {
+		return vm.RaiseExceptionf("integer bound must be a positive integer less than 2^31, not %v", n)
+	}
+	return vm.NewNumber(float64(target.Value.(*randSource).Intn(int(n))))
+}
+
+// RandomSetSeed is a Random method.
+//
+// setSeed reseeds the generator with the given integer, so that it produces
+// the same sequence of values each time it is seeded with the same number.
+// Seeding the Random proto itself is equivalent to System setRandomSeed.
+func RandomSetSeed(vm *VM, target, locals *Object, msg *Message) *Object {
+	n, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	target.Value.(*randSource).Seed(int64(n))
+	return target
+}
+
+// RandomValue is a Random method.
+//
+// value returns a uniform draw in [0, 1).
+func RandomValue(vm *VM, target, locals *Object, msg *Message) *Object {
+	return vm.NewNumber(target.Value.(*randSource).Float64())
+}
+
+// RandomValueBetween is a Random method.
+//
+// valueBetween returns a uniform draw in [a, b) for arguments a and b.
+func RandomValueBetween(vm *VM, target, locals *Object, msg *Message) *Object {
+	a, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	b, exc, stop := msg.NumberArgAt(vm, locals, 1)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	return vm.NewNumber(a + (b-a)*target.Value.(*randSource).Float64())
+}
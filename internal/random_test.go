@@ -0,0 +1,79 @@
+package internal_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/zephyrtronium/iolang/internal"
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+// TestSetRandomSeed tests that seeding the global random source makes methods
+// which use it reproducible.
+func TestSetRandomSeed(t *testing.T) {
+	vm := testutils.VM()
+	draw := func() string {
+		return vm.AsString(vm.MustDoString(`Object clone do(s := list(1, 1, 1, 1, 1, 1, 1, 1) asSequence; r := list(s sampleIndex, s sampleIndex, s sampleIndex, s sampleIndex, s sampleIndex, s sampleIndex)) r`))
+	}
+	vm.SetRandomSeed(1)
+	a := draw()
+	vm.SetRandomSeed(1)
+	if b := draw(); a != b {
+		t.Errorf("draws after SetRandomSeed(1) differ: %s then %s", a, b)
+	}
+	vm.MustDoString(`System setRandomSeed(1)`)
+	if b := draw(); a != b {
+		t.Errorf("draws after System setRandomSeed(1) differ from SetRandomSeed(1): %s then %s", a, b)
+	}
+	shuffle := func() string {
+		return vm.AsString(vm.MustDoString(`Object clone do(r := list(list(1, 2, 3, 4, 5, 6, 7, 8) asSequence shuffle asList, "abcdefgh" sample(4), list(1, 2, 3, 4, 5, 6, 7, 8) shuffle, list(1, 2, 3, 4, 5, 6, 7, 8) randomElement)) r`))
+	}
+	vm.SetRandomSeed(1)
+	c := shuffle()
+	vm.SetRandomSeed(1)
+	if d := shuffle(); c != d {
+		t.Errorf("shuffles and samples after SetRandomSeed(1) differ: %s then %s", c, d)
+	}
+	vm.SetRandomSeed(2)
+	if b := draw(); a == b {
+		// Six draws of eight equally likely indices coincide with
+		// probability 8^-6 for a good generator, so this is a real failure.
+		t.Errorf("draws after seeds 1 and 2 are the same: %s", a)
+	}
+}
+
+// TestRandomSeeded tests that a seeded Random produces the same values as the
+// math/rand generator with the same seed.
+func TestRandomSeeded(t *testing.T) {
+	vm := testutils.VM()
+	r := rand.New(rand.NewSource(42))
+	want := []float64{r.Float64(), r.Float64(), float64(r.Intn(10)), 3 + 2*r.Float64()}
+	got := vm.MustDoString(`Object clone do(g := Random clone setSeed(42); r := list(g value, g value, g integer(10), g valueBetween(3, 5))) r`).Value.([]*internal.Object)
+	if len(got) != len(want) {
+		t.Fatalf("wrong number of values: want %d, got %d", len(want), len(got))
+	}
+	for i, v := range got {
+		if x := v.Value.(float64); x != want[i] {
+			t.Errorf("wrong value %d from Random clone setSeed(42): want %v, got %v", i, want[i], x)
+		}
+	}
+}
+
+// TestRandomMethods tests Random methods.
+func TestRandomMethods(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"value":        {Source: `Random clone value between(0, 1)`, Pass: testutils.PassIdentical(vm.True)},
+		"valueBetween": {Source: `Random clone valueBetween(-2, -1) between(-2, -1)`, Pass: testutils.PassIdentical(vm.True)},
+		"integer":      {Source: `Random clone integer(1)`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+		"setSeed":      {Source: `Random clone setSeed(7) type`, Pass: testutils.PassEqual(vm.NewString("Random"))},
+		"repeat":       {Source: `Object clone do(g := Random clone; h := Random clone; g setSeed(3); h setSeed(3); r := list(g value, g integer(100)) == list(h value, h integer(100))) r`, Pass: testutils.PassIdentical(vm.True)},
+		"independent":  {Source: `Object clone do(g := Random clone setSeed(3); a := g value; g setSeed(3); Random clone setSeed(4) value; r := g value == a) r`, Pass: testutils.PassIdentical(vm.True)},
+		"sampleIndex":  {Source: `list(0, 0, 1) asSequence sampleIndex(Random clone)`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+		"zero":         {Source: `Random clone integer(0)`, Pass: testutils.PassFailure()},
+		"fraction":     {Source: `Random clone integer(2.5)`, Pass: testutils.PassFailure()},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestRandomMethods"))
+	}
+}
@@ -0,0 +1,116 @@
+package internal
+
+import (
+	"bytes"
+	"sync"
+	"sync/atomic"
+	"unicode/utf8"
+)
+
+// A RecordedSend describes one message send observed while recording.
+type RecordedSend struct {
+	// Coro identifies the coroutine which performed the send. It is the
+	// address of the coroutine's Coroutine object.
+	Coro uintptr `json:"coro"`
+	// Target identifies the receiver of the message by its address.
+	Target uintptr `json:"target"`
+	// Tag is the name of the receiver's tag, or "Object" if it has none.
+	Tag string `json:"tag"`
+	// Selector is the name of the message.
+	Selector string `json:"selector"`
+	// Args holds the unevaluated source of each argument to the message,
+	// abbreviated to at most recordArgLen bytes.
+	Args []string `json:"args,omitempty"`
+}
+
+// recordArgLen is the maximum length of an argument summary in a RecordedSend.
+const recordArgLen = 40
+
+// recorder accumulates message sends for all coroutines of a VM.
+type recorder struct {
+	// on is an atomic flag indicating whether sends are being recorded.
+	on uint32
+
+	mu    sync.Mutex
+	sends []RecordedSend
+}
+
+// StartRecording begins recording every message send performed by the VM and
+// all its coroutines, discarding any previously recorded sends. Recording
+// continues until StopRecording is called. While recording is off, the only
+// cost to each send is checking an atomic flag.
+func (vm *VM) StartRecording() {
+	r := vm.rec
+	r.mu.Lock()
+	r.sends = nil
+	r.mu.Unlock()
+	atomic.StoreUint32(&r.on, 1)
+}
+
+// StopRecording stops recording message sends. The sends recorded so far
+// remain available from RecordedSends.
+func (vm *VM) StopRecording() {
+	atomic.StoreUint32(&vm.rec.on, 0)
+}
+
+// RecordedSends returns a copy of the message sends recorded since the last
+// call to StartRecording, in the order they were performed.
+func (vm *VM) RecordedSends() []RecordedSend {
+	r := vm.rec
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]RecordedSend(nil), r.sends...)
+}
+
+// recordSend records a message send if recording is enabled.
+func (vm *VM) recordSend(target *Object, msg *Message) {
+	if atomic.LoadUint32(&vm.rec.on) != 0 {
+		vm.recordSendSlow(target, msg)
+	}
+}
+
+// recordSendSlow is an outlined path of recordSend.
+func (vm *VM) recordSendSlow(target *Object, msg *Message) {
+	tag := "Object"
+	if t := target.Tag(); t != nil {
+		tag = t.String()
+	}
+	send := RecordedSend{
+		Coro:     vm.Coro.UniqueID(),
+		Target:   target.UniqueID(),
+		Tag:      tag,
+		Selector: msg.Name(),
+	}
+	if len(msg.Args) > 0 {
+		send.Args = make([]string, len(msg.Args))
+		for i, arg := range msg.Args {
+			send.Args[i] = summarizeArg(vm, arg)
+		}
+	}
+	r := vm.rec
+	r.mu.Lock()
+	r.sends = append(r.sends, send)
+	r.mu.Unlock()
+}
+
+// summarizeArg returns an abbreviated form of an argument's source. Arguments
+// which are only cached results are summarized by their tags.
+func summarizeArg(vm *VM, arg *Message) string {
+	if arg.Text == "" && arg.Memo != nil {
+		if t := arg.Memo.Tag(); t != nil {
+			return "<" + t.String() + ">"
+		}
+		return "<Object>"
+	}
+	var b bytes.Buffer
+	arg.stringRecurse(vm, &b)
+	s := b.String()
+	if len(s) > recordArgLen {
+		n := recordArgLen - 3
+		for n > 0 && !utf8.RuneStart(s[n]) {
+			n--
+		}
+		s = s[:n] + "..."
+	}
+	return s
+}
@@ -0,0 +1,55 @@
+package internal_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+// TestRecording tests that message sends are recorded only while recording is
+// on, along with their targets and abbreviated arguments.
+func TestRecording(t *testing.T) {
+	vm := testutils.VM()
+	o := vm.NewObject(nil)
+	vm.SetSlot(vm.Lobby, "TestRecording", o)
+	defer vm.RemoveSlot(vm.Lobby, "TestRecording")
+	vm.StartRecording()
+	vm.MustDoString(`TestRecording setSlot("x", "` + strings.Repeat("a", 60) + `")`)
+	vm.StopRecording()
+	vm.MustDoString(`TestRecording x`)
+	sends := vm.RecordedSends()
+	var found bool
+	for _, s := range sends {
+		if s.Selector == "x" {
+			t.Errorf("recorded send after stopping: %+v", s)
+		}
+		if s.Selector != "setSlot" {
+			continue
+		}
+		found = true
+		if s.Target != o.UniqueID() {
+			t.Errorf("wrong target: want %x, got %x", o.UniqueID(), s.Target)
+		}
+		if s.Coro != vm.Coro.UniqueID() {
+			t.Errorf("wrong coroutine: want %x, got %x", vm.Coro.UniqueID(), s.Coro)
+		}
+		if len(s.Args) != 2 {
+			t.Fatalf("wrong number of args: want 2, got %q", s.Args)
+		}
+		if s.Args[0] != `"x"` {
+			t.Errorf("wrong first arg: want %q, got %q", `"x"`, s.Args[0])
+		}
+		if len(s.Args[1]) > 40 || !strings.HasSuffix(s.Args[1], "...") {
+			t.Errorf("long arg not abbreviated: %q", s.Args[1])
+		}
+	}
+	if !found {
+		t.Errorf("setSlot not recorded in %+v", sends)
+	}
+	vm.StartRecording()
+	vm.StopRecording()
+	if sends := vm.RecordedSends(); len(sends) != 0 {
+		t.Errorf("StartRecording didn't discard sends: %+v", sends)
+	}
+}
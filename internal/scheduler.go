@@ -38,6 +38,9 @@ type Scheduler struct {
 	pause chan *VM
 	// finish is a channel for coroutines to indicate deactivation.
 	finish chan *VM
+	// onExit is the list of functions to call when the scheduler stops, in
+	// the order they were registered. It is held by m.
+	onExit []func()
 }
 
 // SchedulerTag is the Tag for the Scheduler.
@@ -112,6 +115,40 @@ func (s *Scheduler) Exit(code int) {
 	}
 }
 
+// OnExit registers f to be called when the VM stops, either because System
+// exit was called or because all coroutines have finished. Functions are
+// called in the reverse of the order in which they were registered, after
+// every coroutine has been told to stop but before buffered output is
+// flushed. A panic in one function is recovered and reported to standard
+// error, and the remaining functions still run. Registering a function after
+// the VM has stopped has no effect.
+func (vm *VM) OnExit(f func()) {
+	vm.Sched.m.Lock()
+	vm.Sched.onExit = append(vm.Sched.onExit, f)
+	vm.Sched.m.Unlock()
+}
+
+// runExitHooks calls the functions registered with OnExit.
+func (s *Scheduler) runExitHooks() {
+	s.m.Lock()
+	hooks := s.onExit
+	s.onExit = nil
+	s.m.Unlock()
+	for i := len(hooks) - 1; i >= 0; i-- {
+		s.runExitHook(hooks[i])
+	}
+}
+
+// runExitHook calls f, recovering and reporting any panic.
+func (s *Scheduler) runExitHook(f func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Fprintln(s.Main.Stderr, "iolang: panic in exit hook:", r)
+		}
+	}()
+	f()
+}
+
 // reallyExit sends ExitStop to every active coroutine.
 func (s *Scheduler) reallyExit() {
 	s.m.Lock()
@@ -127,6 +164,9 @@ func (s *Scheduler) reallyExit() {
 func (s *Scheduler) schedule(ready chan struct{}) {
 	alive := make(chan bool)
 	defer close(alive)
+	// Write any buffered output before announcing that we're done.
+	defer s.Main.Stdout.Flush()
+	defer s.runExitHooks()
 	s.Alive = alive
 	s.exit = make(chan int)
 	signal.Notify(s.interrupt, os.Interrupt)
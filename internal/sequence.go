@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"strings"
 )
 
 // There are a *lot* of Sequence methods, and each one needs to be able to
@@ -110,6 +111,35 @@ var (
 // SeqMaxItemSize is the maximum size in bytes of a single sequence element.
 const SeqMaxItemSize = 8
 
+// SeqKindNamed returns the sequence kind with the given item type name, e.g.
+// "uint8" or "float64", ignoring case. The second result is false if there is
+// no such kind.
+func SeqKindNamed(name string) (SeqKind, bool) {
+	switch strings.ToLower(name) {
+	case "uint8":
+		return SeqU8, true
+	case "uint16":
+		return SeqU16, true
+	case "uint32":
+		return SeqU32, true
+	case "uint64":
+		return SeqU64, true
+	case "int8":
+		return SeqS8, true
+	case "int16":
+		return SeqS16, true
+	case "int32":
+		return SeqS32, true
+	case "int64":
+		return SeqS64, true
+	case "float32":
+		return SeqF32, true
+	case "float64":
+		return SeqF64, true
+	}
+	return SeqKind{}, false
+}
+
 // Encoding returns the suggested default encoding for the sequence kind. This
 // is utf8 for uint8 kinds, utf16 for uint16, utf32 for int32, and number for
 // all other kinds.
@@ -1163,6 +1193,7 @@ func (vm *VM) initSequence() {
 		// sequence_immutable.go:
 		"afterSeq":         vm.NewCFunction(SequenceAfterSeq, SequenceTag),
 		"asList":           vm.NewCFunction(SequenceAsList, SequenceTag),
+		"asReversed":       vm.NewCFunction(SequenceAsReversed, SequenceTag),
 		"asStruct":         vm.NewCFunction(SequenceAsStruct, SequenceTag),
 		"asSymbol":         vm.NewCFunction(SequenceAsSymbol, SequenceTag),
 		"at":               vm.NewCFunction(SequenceAt, SequenceTag),
@@ -1180,6 +1211,7 @@ func (vm *VM) initSequence() {
 		"findSeq":          vm.NewCFunction(SequenceFindSeq, SequenceTag),
 		"findSeqs":         vm.NewCFunction(SequenceFindSeqs, SequenceTag),
 		"foreach":          vm.NewCFunction(SequenceForeach, SequenceTag),
+		"fromRunLength":    vm.NewCFunction(SequenceFromRunLength, nil),
 		"hash":             vm.NewCFunction(SequenceHash, SequenceTag),
 		"inSlice":          vm.NewCFunction(SequenceInSlice, SequenceTag),
 		"isMutable":        vm.NewCFunction(SequenceIsMutable, SequenceTag),
@@ -1188,8 +1220,11 @@ func (vm *VM) initSequence() {
 		"itemType":         vm.NewCFunction(SequenceItemType, SequenceTag),
 		"occurrencesOfSeq": vm.NewCFunction(SequenceOccurrencesOfSeq, SequenceTag),
 		"pack":             vm.NewCFunction(SequencePack, nil),
+		"repeated":         vm.NewCFunction(SequenceRepeated, SequenceTag),
 		"reverseFindSeq":   vm.NewCFunction(SequenceReverseFindSeq, SequenceTag),
+		"runLengthEncode":  vm.NewCFunction(SequenceRunLengthEncode, SequenceTag),
 		"size":             vm.NewCFunction(SequenceSize, SequenceTag),
+		"sample":           vm.NewCFunction(SequenceSample, SequenceTag),
 		"splitAt":          vm.NewCFunction(SequenceSplitAt, SequenceTag),
 		"unpack":           vm.NewCFunction(SequenceUnpack, SequenceTag),
 		"withStruct":       vm.NewCFunction(SequenceWithStruct, nil),
@@ -1210,6 +1245,7 @@ func (vm *VM) initSequence() {
 		"empty":               vm.NewCFunction(SequenceEmpty, SequenceTag),
 		"insertSeqEvery":      vm.NewCFunction(SequenceInsertSeqEvery, SequenceTag),
 		"leaveThenRemove":     vm.NewCFunction(SequenceLeaveThenRemove, SequenceTag),
+		"padToItemSize":       vm.NewCFunction(SequencePadToItemSize, SequenceTag),
 		"preallocateToSize":   vm.NewCFunction(SequencePreallocateToSize, SequenceTag),
 		"rangeFill":           vm.NewCFunction(SequenceRangeFill, SequenceTag),
 		"removeAt":            vm.NewCFunction(SequenceRemoveAt, SequenceTag),
@@ -1222,54 +1258,84 @@ func (vm *VM) initSequence() {
 		"removeSuffix":        vm.NewCFunction(SequenceRemoveSuffix, SequenceTag),
 		"replaceFirstSeq":     vm.NewCFunction(SequenceReplaceFirstSeq, SequenceTag),
 		"replaceSeq":          vm.NewCFunction(SequenceReplaceSeq, SequenceTag),
+		"replaceSeqWith":      vm.NewCFunction(SequenceReplaceSeqWith, SequenceTag),
 		"reverseInPlace":      vm.NewCFunction(SequenceReverseInPlace, SequenceTag),
 		"setItemType":         vm.NewCFunction(SequenceSetItemType, SequenceTag),
 		"setItemsToDouble":    vm.NewCFunction(SequenceSetItemsToDouble, SequenceTag),
 		"setSize":             vm.NewCFunction(SequenceSetSize, SequenceTag),
+		"shuffle":             vm.NewCFunction(SequenceShuffle, SequenceTag),
 		"sort":                vm.NewCFunction(SequenceSort, SequenceTag),
 		"zero":                vm.NewCFunction(SequenceZero, SequenceTag),
 
 		// sequence_string.go:
-		"appendPathSeq":          vm.NewCFunction(SequenceAppendPathSeq, SequenceTag),
-		"asBase64":               vm.NewCFunction(SequenceAsBase64, SequenceTag),
-		"asFixedSizeType":        vm.NewCFunction(SequenceAsFixedSizeType, SequenceTag),
-		"asIoPath":               vm.NewCFunction(SequenceAsIoPath, SequenceTag),
-		"asJson":                 vm.NewCFunction(SequenceAsJSON, SequenceTag),
-		"asLatin1":               vm.NewCFunction(SequenceAsLatin1, SequenceTag),
-		"asMessage":              vm.NewCFunction(SequenceAsMessage, SequenceTag),
-		"asNumber":               vm.NewCFunction(SequenceAsNumber, SequenceTag),
-		"asOSPath":               vm.NewCFunction(SequenceAsOSPath, SequenceTag),
-		"asUTF16":                vm.NewCFunction(SequenceAsUTF16, SequenceTag),
-		"asUTF32":                vm.NewCFunction(SequenceAsUTF32, SequenceTag),
-		"asUTF8":                 vm.NewCFunction(SequenceAsUTF8, SequenceTag),
-		"capitalize":             vm.NewCFunction(SequenceCapitalize, SequenceTag),
-		"cloneAppendPath":        vm.NewCFunction(SequenceCloneAppendPath, SequenceTag),
-		"convertToFixedSizeType": vm.NewCFunction(SequenceConvertToFixedSizeType, SequenceTag),
-		"encoding":               vm.NewCFunction(SequenceEncoding, SequenceTag),
-		"escape":                 vm.NewCFunction(SequenceEscape, SequenceTag),
-		"fromBase":               vm.NewCFunction(SequenceFromBase, SequenceTag),
-		"fromBase64":             vm.NewCFunction(SequenceFromBase64, SequenceTag),
-		"interpolate":            vm.NewCFunction(SequenceInterpolate, SequenceTag),
-		"isLowercase":            vm.NewCFunction(SequenceIsLowercase, SequenceTag),
-		"isUppercase":            vm.NewCFunction(SequenceIsUppercase, SequenceTag),
-		"lastPathComponent":      vm.NewCFunction(SequenceLastPathComponent, SequenceTag),
-		"lowercase":              vm.NewCFunction(SequenceLowercase, SequenceTag),
-		"lstrip":                 vm.NewCFunction(SequenceLstrip, SequenceTag),
-		"setEncoding":            vm.NewCFunction(SequenceSetEncoding, SequenceTag),
-		"parseJson":              vm.NewCFunction(SequenceParseJSON, SequenceTag),
-		"pathComponent":          vm.NewCFunction(SequencePathComponent, SequenceTag),
-		"pathExtension":          vm.NewCFunction(SequencePathExtension, SequenceTag),
-		"percentDecoded":         vm.NewCFunction(SequencePercentDecoded, SequenceTag),
-		"percentEncoded":         vm.NewCFunction(SequencePercentEncoded, SequenceTag),
-		"rstrip":                 vm.NewCFunction(SequenceRstrip, SequenceTag),
-		"split":                  vm.NewCFunction(SequenceSplit, SequenceTag),
-		"strip":                  vm.NewCFunction(SequenceStrip, SequenceTag),
-		"toBase":                 vm.NewCFunction(SequenceToBase, SequenceTag),
-		"unescape":               vm.NewCFunction(SequenceUnescape, SequenceTag),
-		"uppercase":              vm.NewCFunction(SequenceUppercase, SequenceTag),
-		"urlDecoded":             vm.NewCFunction(SequenceURLDecoded, SequenceTag),
-		"urlEncoded":             vm.NewCFunction(SequenceURLEncoded, SequenceTag),
-		"validEncodings":         vm.NewCFunction(SequenceValidEncodings, nil),
+		"appendPathSeq":              vm.NewCFunction(SequenceAppendPathSeq, SequenceTag),
+		"asBase64":                   vm.NewCFunction(SequenceAsBase64, SequenceTag),
+		"asDisplayString":            vm.NewCFunction(SequenceAsDisplayString, SequenceTag),
+		"asFixedSizeType":            vm.NewCFunction(SequenceAsFixedSizeType, SequenceTag),
+		"asGraphemes":                vm.NewCFunction(SequenceAsGraphemes, SequenceTag),
+		"asIoPath":                   vm.NewCFunction(SequenceAsIoPath, SequenceTag),
+		"asJson":                     vm.NewCFunction(SequenceAsJSON, SequenceTag),
+		"asLatin1":                   vm.NewCFunction(SequenceAsLatin1, SequenceTag),
+		"asMessage":                  vm.NewCFunction(SequenceAsMessage, SequenceTag),
+		"asNumber":                   vm.NewCFunction(SequenceAsNumber, SequenceTag),
+		"asNumberOrNil":              vm.NewCFunction(SequenceAsNumberOrNil, SequenceTag),
+		"asOSPath":                   vm.NewCFunction(SequenceAsOSPath, SequenceTag),
+		"asUTF16":                    vm.NewCFunction(SequenceAsUTF16, SequenceTag),
+		"asUTF32":                    vm.NewCFunction(SequenceAsUTF32, SequenceTag),
+		"asUTF8":                     vm.NewCFunction(SequenceAsUTF8, SequenceTag),
+		"capitalize":                 vm.NewCFunction(SequenceCapitalize, SequenceTag),
+		"capitalizeWords":            vm.NewCFunction(SequenceCapitalizeWords, SequenceTag),
+		"charAt":                     vm.NewCFunction(SequenceCharAt, SequenceTag),
+		"chomp":                      vm.NewCFunction(SequenceChomp, SequenceTag),
+		"chompInPlace":               vm.NewCFunction(SequenceChompInPlace, SequenceTag),
+		"cloneAppendPath":            vm.NewCFunction(SequenceCloneAppendPath, SequenceTag),
+		"containsFolded":             vm.NewCFunction(SequenceContainsFolded, SequenceTag),
+		"containsSeqCaseInsensitive": vm.NewCFunction(SequenceContainsSeqCaseInsensitive, SequenceTag),
+		"convertToFixedSizeType":     vm.NewCFunction(SequenceConvertToFixedSizeType, SequenceTag),
+		"dedent":                     vm.NewCFunction(SequenceDedent, SequenceTag),
+		"detectEncoding":             vm.NewCFunction(SequenceDetectEncoding, SequenceTag),
+		"encoding":                   vm.NewCFunction(SequenceEncoding, SequenceTag),
+		"escape":                     vm.NewCFunction(SequenceEscape, SequenceTag),
+		"escapeHtml":                 vm.NewCFunction(SequenceEscapeHTML, SequenceTag),
+		"findSeqCaseInsensitive":     vm.NewCFunction(SequenceFindSeqCaseInsensitive, SequenceTag),
+		"foreachLine":                vm.NewCFunction(SequenceForeachLine, SequenceTag),
+		"format":                     vm.NewCFunction(SequenceFormat, SequenceTag),
+		"formatTable":                vm.NewCFunction(SequenceFormatTable, nil),
+		"fromBase":                   vm.NewCFunction(SequenceFromBase, SequenceTag),
+		"fromBase64":                 vm.NewCFunction(SequenceFromBase64, SequenceTag),
+		"indentBy":                   vm.NewCFunction(SequenceIndentBy, SequenceTag),
+		"insertEvery":                vm.NewCFunction(SequenceInsertEvery, SequenceTag),
+		"interpolate":                vm.NewCFunction(SequenceInterpolate, SequenceTag),
+		"isLowercase":                vm.NewCFunction(SequenceIsLowercase, SequenceTag),
+		"isUppercase":                vm.NewCFunction(SequenceIsUppercase, SequenceTag),
+		"lastPathComponent":          vm.NewCFunction(SequenceLastPathComponent, SequenceTag),
+		"lowercase":                  vm.NewCFunction(SequenceLowercase, SequenceTag),
+		"lstrip":                     vm.NewCFunction(SequenceLstrip, SequenceTag),
+		"numberLines":                vm.NewCFunction(SequenceNumberLines, SequenceTag),
+		"setEncoding":                vm.NewCFunction(SequenceSetEncoding, SequenceTag),
+		"padLeft":                    vm.NewCFunction(SequencePadLeft, SequenceTag),
+		"padRight":                   vm.NewCFunction(SequencePadRight, SequenceTag),
+		"parseBoolean":               vm.NewCFunction(SequenceParseBoolean, SequenceTag),
+		"parseJson":                  vm.NewCFunction(SequenceParseJSON, SequenceTag),
+		"pathComponent":              vm.NewCFunction(SequencePathComponent, SequenceTag),
+		"pathExtension":              vm.NewCFunction(SequencePathExtension, SequenceTag),
+		"percentDecoded":             vm.NewCFunction(SequencePercentDecoded, SequenceTag),
+		"percentEncoded":             vm.NewCFunction(SequencePercentEncoded, SequenceTag),
+		"removeBom":                  vm.NewCFunction(SequenceRemoveBom, SequenceTag),
+		"renderTemplate":             vm.NewCFunction(SequenceRenderTemplate, SequenceTag),
+		"rstrip":                     vm.NewCFunction(SequenceRstrip, SequenceTag),
+		"split":                      vm.NewCFunction(SequenceSplit, SequenceTag),
+		"splitLines":                 vm.NewCFunction(SequenceSplitLines, SequenceTag),
+		"splitRespectingQuotes":      vm.NewCFunction(SequenceSplitRespectingQuotes, SequenceTag),
+		"strip":                      vm.NewCFunction(SequenceStrip, SequenceTag),
+		"stripAnsi":                  vm.NewCFunction(SequenceStripAnsi, SequenceTag),
+		"toBase":                     vm.NewCFunction(SequenceToBase, SequenceTag),
+		"unescape":                   vm.NewCFunction(SequenceUnescape, SequenceTag),
+		"unescapeHtml":               vm.NewCFunction(SequenceUnescapeHTML, SequenceTag),
+		"uppercase":                  vm.NewCFunction(SequenceUppercase, SequenceTag),
+		"urlDecoded":                 vm.NewCFunction(SequenceURLDecoded, SequenceTag),
+		"urlEncoded":                 vm.NewCFunction(SequenceURLEncoded, SequenceTag),
+		"validEncodings":             vm.NewCFunction(SequenceValidEncodings, nil),
 
 		// sequence_math.go:
 		"**=":                     vm.NewCFunction(SequenceStarStarEq, SequenceTag),
@@ -1306,6 +1372,7 @@ func (vm *VM) initSequence() {
 		"negate":                  vm.NewCFunction(SequenceNegate, SequenceTag),
 		"normalize":               vm.NewCFunction(SequenceNormalize, SequenceTag),
 		"product":                 vm.NewCFunction(SequenceProduct, SequenceTag),
+		"sampleIndex":             vm.NewCFunction(SequenceSampleIndex, SequenceTag),
 		"sin":                     vm.NewCFunction(SequenceSin, SequenceTag),
 		"sinh":                    vm.NewCFunction(SequenceSinh, SequenceTag),
 		"sqrt":                    vm.NewCFunction(SequenceSqrt, SequenceTag),
@@ -1313,6 +1380,20 @@ func (vm *VM) initSequence() {
 		"square":                  vm.NewCFunction(SequenceSquare, SequenceTag),
 		"tan":                     vm.NewCFunction(SequenceTan, SequenceTag),
 		"tanh":                    vm.NewCFunction(SequenceTanh, SequenceTag),
+
+		// sequence_hash.go:
+		"crc32": vm.NewCFunction(SequenceCrc32, SequenceTag),
+		"hmac":  vm.NewCFunction(SequenceHmac, SequenceTag),
+
+		// sequence_regex.go:
+		"allMatchesOfRegex": vm.NewCFunction(SequenceAllMatchesOfRegex, SequenceTag),
+		"captureGroups":     vm.NewCFunction(SequenceCaptureGroups, SequenceTag),
+		"matchesRegex":      vm.NewCFunction(SequenceMatchesRegex, SequenceTag),
+		"replaceRegex":      vm.NewCFunction(SequenceReplaceRegex, SequenceTag),
+
+		// sequence_diff.go:
+		"diff":         vm.NewCFunction(SequenceDiff, SequenceTag),
+		"editDistance": vm.NewCFunction(SequenceEditDistance, SequenceTag),
 	}
 	slots["addEquals"] = slots["+="]
 	slots["asBuffer"] = slots["asMutable"]
@@ -1320,6 +1401,7 @@ func (vm *VM) initSequence() {
 	slots["betweenSeq"] = slots["between"]
 	slots["exclusiveSlice"] = slots["exSlice"]
 	slots["inclusiveSlice"] = slots["inSlice"]
+	slots["levenshtein"] = slots["editDistance"]
 	slots["slice"] = slots["exSlice"]
 	value := Sequence{
 		Value:   []byte(nil),
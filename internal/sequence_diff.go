@@ -0,0 +1,237 @@
+package internal
+
+// A DiffOp is one operation of an edit script produced by Diff.
+type DiffOp struct {
+	// Op is "equal", "delete", or "insert".
+	Op string
+	// Text is the run of text the operation applies to.
+	Text string
+}
+
+// Diff computes an edit script transforming a into b by runes, using a longest
+// common subsequence. Adjacent operations of the same kind are merged, and
+// within each changed region, deletions precede insertions. Time and space are
+// proportional to the product of the lengths of a and b after removing their
+// common prefix and suffix.
+func Diff(a, b string) []DiffOp {
+	x, y := []rune(a), []rune(b)
+	p := 0
+	for p < len(x) && p < len(y) && x[p] == y[p] {
+		p++
+	}
+	s := 0
+	for s < len(x)-p && s < len(y)-p && x[len(x)-s-1] == y[len(y)-s-1] {
+		s++
+	}
+	var d differ
+	d.emit("equal", x[:p])
+	mx, my := x[p:len(x)-s], y[p:len(y)-s]
+	// lcs[i][j] is the length of the LCS of mx[i:] and my[j:].
+	lcs := make([][]int, len(mx)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(my)+1)
+	}
+	for i := len(mx) - 1; i >= 0; i-- {
+		for j := len(my) - 1; j >= 0; j-- {
+			if mx[i] == my[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	i, j := 0, 0
+	for i < len(mx) && j < len(my) {
+		switch {
+		case mx[i] == my[j]:
+			d.emit("equal", mx[i:i+1])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			d.emit("delete", mx[i:i+1])
+			i++
+		default:
+			d.emit("insert", my[j:j+1])
+			j++
+		}
+	}
+	d.emit("delete", mx[i:])
+	d.emit("insert", my[j:])
+	d.emit("equal", x[len(x)-s:])
+	return d.flush()
+}
+
+// differ accumulates diff operations. Within a changed region, it keeps
+// deletions and insertions separate so that they can be emitted in a
+// consistent order.
+type differ struct {
+	ops      []DiffOp
+	del, ins []rune
+}
+
+func (d *differ) emit(op string, text []rune) {
+	if len(text) == 0 {
+		return
+	}
+	switch op {
+	case "delete":
+		d.del = append(d.del, text...)
+	case "insert":
+		d.ins = append(d.ins, text...)
+	default:
+		d.push("delete", d.del)
+		d.push("insert", d.ins)
+		d.del, d.ins = d.del[:0], d.ins[:0]
+		d.push(op, text)
+	}
+}
+
+func (d *differ) push(op string, text []rune) {
+	if len(text) == 0 {
+		return
+	}
+	if n := len(d.ops) - 1; n >= 0 && d.ops[n].Op == op {
+		d.ops[n].Text += string(text)
+		return
+	}
+	d.ops = append(d.ops, DiffOp{Op: op, Text: string(text)})
+}
+
+func (d *differ) flush() []DiffOp {
+	d.push("delete", d.del)
+	d.push("insert", d.ins)
+	d.del, d.ins = nil, nil
+	return d.ops
+}
+
+// EditCosts are the weights of each kind of edit in EditDistance.
+type EditCosts struct {
+	Insert, Delete, Substitute float64
+}
+
+// UnitEditCosts gives every edit a cost of 1, making EditDistance the
+// Levenshtein distance.
+var UnitEditCosts = EditCosts{Insert: 1, Delete: 1, Substitute: 1}
+
+// EditDistance computes the minimum total cost of insertions, deletions, and
+// substitutions of runes transforming a into b. Time is proportional to the
+// product of the lengths of a and b, and space to the lesser of them.
+func EditDistance(a, b string, c EditCosts) float64 {
+	x, y := []rune(a), []rune(b)
+	if len(y) > len(x) {
+		// Keep the rows as short as possible. Transforming b into a swaps
+		// the roles of insertion and deletion.
+		x, y = y, x
+		c.Insert, c.Delete = c.Delete, c.Insert
+	}
+	// prev[j] is the cost of transforming x[:i] into y[:j].
+	prev := make([]float64, len(y)+1)
+	cur := make([]float64, len(y)+1)
+	for j := range prev {
+		prev[j] = float64(j) * c.Insert
+	}
+	for i := range x {
+		cur[0] = float64(i+1) * c.Delete
+		for j := range y {
+			d := prev[j]
+			if x[i] != y[j] {
+				d += c.Substitute
+			}
+			if v := prev[j+1] + c.Delete; v < d {
+				d = v
+			}
+			if v := cur[j] + c.Insert; v < d {
+				d = v
+			}
+			cur[j+1] = d
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(y)]
+}
+
+// SequenceDiff is a Sequence method.
+//
+// diff returns a list of edit operations transforming the receiver into the
+// argument, comparing decoded characters. Each operation is an object with an
+// op slot, one of "equal", "delete", or "insert", and a text slot holding the
+// run of text to which it applies. Concatenating the text of the equal and
+// delete operations gives the receiver; concatenating that of the equal and
+// insert operations gives the argument.
+func SequenceDiff(vm *VM, target, locals *Object, msg *Message) *Object {
+	other, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	s := holdSeq(target)
+	sv := s.String()
+	unholdSeq(s.Mutable, target)
+	ops := Diff(sv, other)
+	l := make([]*Object, len(ops))
+	for i, op := range ops {
+		l[i] = vm.NewObject(Slots{"op": vm.NewString(op.Op), "text": vm.NewString(op.Text)})
+	}
+	return vm.NewList(l...)
+}
+
+// SequenceEditDistance is a Sequence method.
+//
+// editDistance returns the Levenshtein distance between the receiver and the
+// argument, comparing decoded characters. An optional Map may give the costs
+// of "insert", "delete", and "substitute" edits, each of which defaults to 1.
+// levenshtein is an alias of editDistance.
+//
+//   io> "kitten" editDistance("sitting")
+//   3
+//   io> "kitten" editDistance("sitting", Map clone atPut("substitute", 2))
+//   5
+func SequenceEditDistance(vm *VM, target, locals *Object, msg *Message) *Object {
+	o, obj, stop := msg.SequenceArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(obj, stop)
+	}
+	if o.IsMutable() {
+		obj.Lock()
+	}
+	other := o.String()
+	if o.IsMutable() {
+		obj.Unlock()
+	}
+	c := UnitEditCosts
+	if msg.ArgCount() > 1 {
+		m, stop := msg.EvalArgAt(vm, locals, 1)
+		if stop != NoStop {
+			return vm.Stop(m, stop)
+		}
+		if m.Tag() != MapTag {
+			return vm.RaiseExceptionf("argument 1 to editDistance must be Map, not %s", vm.TypeName(m))
+		}
+		m.Lock()
+		w := m.Value.(map[string]*Object)
+		ins, del, sub := w["insert"], w["delete"], w["substitute"]
+		m.Unlock()
+		for _, k := range []struct {
+			name string
+			v    *Object
+			c    *float64
+		}{{"insert", ins, &c.Insert}, {"delete", del, &c.Delete}, {"substitute", sub, &c.Substitute}} {
+			if k.v == nil {
+				continue
+			}
+			x, ok := k.v.Value.(float64)
+			if !ok {
+				return vm.RaiseExceptionf("%s cost must be Number, not %s", k.name, vm.TypeName(k.v))
+			}
+			if x < 0 {
+				return vm.RaiseExceptionf("%s cost must be non-negative, not %v", k.name, x)
+			}
+			*k.c = x
+		}
+	}
+	s := holdSeq(target)
+	sv := s.String()
+	unholdSeq(s.Mutable, target)
+	return vm.NewNumber(EditDistance(sv, other, c))
+}
@@ -0,0 +1,123 @@
+package internal_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zephyrtronium/iolang"
+	"github.com/zephyrtronium/iolang/internal"
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+// TestDiff tests that Diff produces minimal edit scripts with merged runs.
+func TestDiff(t *testing.T) {
+	cases := map[string]struct {
+		a, b string
+		want []internal.DiffOp
+	}{
+		"empty":   {"", "", nil},
+		"same":    {"abc", "abc", []internal.DiffOp{{"equal", "abc"}}},
+		"insert":  {"", "abc", []internal.DiffOp{{"insert", "abc"}}},
+		"delete":  {"abc", "", []internal.DiffOp{{"delete", "abc"}}},
+		"middle":  {"abc", "aXc", []internal.DiffOp{{"equal", "a"}, {"delete", "b"}, {"insert", "X"}, {"equal", "c"}}},
+		"append":  {"ab", "abcd", []internal.DiffOp{{"equal", "ab"}, {"insert", "cd"}}},
+		"prepend": {"cd", "abcd", []internal.DiffOp{{"insert", "ab"}, {"equal", "cd"}}},
+		"kitten":  {"kitten", "sitting", []internal.DiffOp{{"delete", "k"}, {"insert", "s"}, {"equal", "itt"}, {"delete", "e"}, {"insert", "i"}, {"equal", "n"}, {"insert", "g"}}},
+		"runes":   {"café", "cafe", []internal.DiffOp{{"equal", "caf"}, {"delete", "é"}, {"insert", "e"}}},
+		"lcs":     {"abcbdab", "bdcaba", nil},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			ops := internal.Diff(c.a, c.b)
+			var a, b string
+			for i, op := range ops {
+				switch op.Op {
+				case "equal":
+					a += op.Text
+					b += op.Text
+				case "delete":
+					a += op.Text
+				case "insert":
+					b += op.Text
+				default:
+					t.Errorf("op %d has unknown kind %q", i, op.Op)
+				}
+				if i > 0 && ops[i-1].Op == op.Op {
+					t.Errorf("ops %d and %d are both %s", i-1, i, op.Op)
+				}
+			}
+			if a != c.a || b != c.b {
+				t.Errorf("edit script %v reconstructs %q, %q; want %q, %q", ops, a, b, c.a, c.b)
+			}
+			if c.want != nil && !reflect.DeepEqual(ops, c.want) {
+				t.Errorf("wrong edit script: want %v, got %v", c.want, ops)
+			}
+		})
+	}
+}
+
+// TestSequenceDiff tests the Sequence diff method.
+func TestSequenceDiff(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"ops":       {Source: `"abc" diff("aXc") map(op)`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("equal"), vm.NewString("delete"), vm.NewString("insert"), vm.NewString("equal")))},
+		"text":      {Source: `"abc" diff("aXc") map(text)`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a"), vm.NewString("b"), vm.NewString("X"), vm.NewString("c")))},
+		"empty":     {Source: `"" diff("")`, Pass: testutils.PassEqual(vm.NewList())},
+		"encoding":  {Source: `"ab" asUTF16 diff("ab") map(op)`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("equal")))},
+		"bad":       {Source: `"abc" diff(1)`, Pass: testutils.PassFailure()},
+		"continue":  {Source: `"abc" diff(continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+		"exception": {Source: `"abc" diff(Exception raise)`, Pass: testutils.PassFailure()},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestSequenceDiff/"+name))
+	}
+}
+
+// TestEditDistance tests EditDistance with unit and weighted costs.
+func TestEditDistance(t *testing.T) {
+	cases := map[string]struct {
+		a, b string
+		c    internal.EditCosts
+		want float64
+	}{
+		"empty":      {"", "", internal.UnitEditCosts, 0},
+		"same":       {"abc", "abc", internal.UnitEditCosts, 0},
+		"insert":     {"", "abc", internal.UnitEditCosts, 3},
+		"delete":     {"abc", "", internal.UnitEditCosts, 3},
+		"kitten":     {"kitten", "sitting", internal.UnitEditCosts, 3},
+		"sitting":    {"sitting", "kitten", internal.UnitEditCosts, 3},
+		"runes":      {"café", "cafe", internal.UnitEditCosts, 1},
+		"substitute": {"kitten", "sitting", internal.EditCosts{Insert: 1, Delete: 1, Substitute: 2}, 5},
+		"asymmetric": {"ab", "abcd", internal.EditCosts{Insert: 3, Delete: 1, Substitute: 1}, 6},
+		"swapped":    {"abcd", "ab", internal.EditCosts{Insert: 3, Delete: 1, Substitute: 1}, 2},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := internal.EditDistance(c.a, c.b, c.c); got != c.want {
+				t.Errorf("wrong distance from %q to %q: want %v, got %v", c.a, c.b, c.want, got)
+			}
+		})
+	}
+}
+
+// TestSequenceEditDistance tests the Sequence editDistance method.
+func TestSequenceEditDistance(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"kitten":    {Source: `"kitten" editDistance("sitting")`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+		"empty":     {Source: `"" editDistance("abc")`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+		"encoding":  {Source: `"ab" asUTF16 editDistance("ab")`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+		"weights":   {Source: `"kitten" editDistance("sitting", Map clone atPut("substitute", 2))`, Pass: testutils.PassEqual(vm.NewNumber(5))},
+		"badWeight": {Source: `"kitten" editDistance("sitting", Map clone atPut("insert", "x"))`, Pass: testutils.PassFailure()},
+		"negative":  {Source: `"kitten" editDistance("sitting", Map clone atPut("delete", -1))`, Pass: testutils.PassFailure()},
+		"notMap":    {Source: `"kitten" editDistance("sitting", 1)`, Pass: testutils.PassFailure()},
+		"runes":     {Source: `"naïve" editDistance("naive")`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+		"mutable":   {Source: `"kitten" editDistance("sitting" asMutable)`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+		"alias":     {Source: `"flaw" levenshtein("lawn")`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+		"bad":       {Source: `"abc" editDistance(1)`, Pass: testutils.PassFailure()},
+		"continue":  {Source: `"abc" editDistance(continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+	}
+	for name, c := range cases {
+		t.Run(name, c.TestFunc("TestSequenceEditDistance/"+name))
+	}
+}
@@ -0,0 +1,90 @@
+package internal
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"hash/crc32"
+	"strings"
+)
+
+// SequenceCrc32 is a Sequence method.
+//
+// crc32 returns the CRC-32 checksum of the sequence's bytes. The optional
+// argument names the polynomial to use, either "IEEE", the default, which
+// matches zip and PNG checksums, or "Castagnoli".
+func SequenceCrc32(vm *VM, target, locals *Object, msg *Message) *Object {
+	tab := crc32.IEEETable
+	if msg.ArgCount() > 0 {
+		poly, exc, stop := msg.StringArgAt(vm, locals, 0)
+		if stop != NoStop {
+			return vm.Stop(exc, stop)
+		}
+		switch strings.ToLower(poly) {
+		case "ieee":
+			// do nothing
+		case "castagnoli":
+			tab = crc32.MakeTable(crc32.Castagnoli)
+		default:
+			return vm.RaiseExceptionf("unknown CRC-32 polynomial %q", poly)
+		}
+	}
+	s := holdSeq(target)
+	c := crc32.Checksum(s.Bytes(), tab)
+	unholdSeq(s.Mutable, target)
+	return vm.NewNumber(float64(c))
+}
+
+// SequenceHmac is a Sequence method.
+//
+// hmac returns the hex digest of the HMAC of the sequence's bytes using the
+// first argument's bytes as the key. The optional second argument names the
+// underlying hash, one of "md5", "sha1", "sha224", "sha256", the default,
+// "sha384", or "sha512".
+func SequenceHmac(vm *VM, target, locals *Object, msg *Message) *Object {
+	key, obj, stop := msg.SequenceArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(obj, stop)
+	}
+	h := sha256.New
+	if msg.ArgCount() > 1 {
+		alg, exc, stop := msg.StringArgAt(vm, locals, 1)
+		if stop != NoStop {
+			return vm.Stop(exc, stop)
+		}
+		switch strings.ToLower(alg) {
+		case "md5":
+			h = md5.New
+		case "sha1":
+			h = sha1.New
+		case "sha224":
+			h = sha256.New224
+		case "sha256":
+			// do nothing
+		case "sha384":
+			h = sha512.New384
+		case "sha512":
+			h = sha512.New
+		default:
+			return vm.RaiseExceptionf("unknown HMAC algorithm %q", alg)
+		}
+	}
+	// hmac.New copies the key, so we don't need to hold the key sequence
+	// while computing the digest.
+	var mac hash.Hash
+	if key.IsMutable() {
+		obj.Lock()
+		mac = hmac.New(h, key.Bytes())
+		obj.Unlock()
+	} else {
+		mac = hmac.New(h, key.Bytes())
+	}
+	s := holdSeq(target)
+	mac.Write(s.Bytes())
+	unholdSeq(s.Mutable, target)
+	return vm.NewString(hex.EncodeToString(mac.Sum(nil)))
+}
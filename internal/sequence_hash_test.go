@@ -0,0 +1,38 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+// TestSequenceHashMethods tests Sequence checksum and hash methods.
+func TestSequenceHashMethods(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]map[string]testutils.SourceTestCase{
+		"crc32": {
+			"empty":      {Source: `"" crc32`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"check":      {Source: `"123456789" crc32`, Pass: testutils.PassEqual(vm.NewNumber(0xcbf43926))},
+			"ieee":       {Source: `"123456789" crc32("IEEE")`, Pass: testutils.PassEqual(vm.NewNumber(0xcbf43926))},
+			"castagnoli": {Source: `"123456789" crc32("Castagnoli")`, Pass: testutils.PassEqual(vm.NewNumber(0xe3069283))},
+			"mutable":    {Source: `"123456789" asMutable crc32`, Pass: testutils.PassEqual(vm.NewNumber(0xcbf43926))},
+			"unknown":    {Source: `"123456789" crc32("Koopman")`, Pass: testutils.PassFailure()},
+		},
+		// Vectors are from RFC 4231 test case 2.
+		"hmac": {
+			"sha256":  {Source: `"what do ya want for nothing?" hmac("Jefe")`, Pass: testutils.PassEqual(vm.NewString("5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843"))},
+			"named":   {Source: `"what do ya want for nothing?" hmac("Jefe", "SHA256")`, Pass: testutils.PassEqual(vm.NewString("5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843"))},
+			"sha512":  {Source: `"what do ya want for nothing?" hmac("Jefe", "sha512")`, Pass: testutils.PassEqual(vm.NewString("164b7a7bfcf819e2e395fbe73b56e0a387bd64222e831fd610270cd7ea2505549758bf75c05a994a6d034f65f8f0e6fdcaeab1a34d4a6b4b636e070a38bce737"))},
+			"mutable": {Source: `"what do ya want for nothing?" asMutable hmac("Jefe" asMutable)`, Pass: testutils.PassEqual(vm.NewString("5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843"))},
+			"unknown": {Source: `"what do ya want for nothing?" hmac("Jefe", "whirlpool")`, Pass: testutils.PassFailure()},
+			"badKey":  {Source: `"what do ya want for nothing?" hmac(1)`, Pass: testutils.PassFailure()},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			for name, s := range c {
+				t.Run(name, s.TestFunc("TestSequenceHashMethods"))
+			}
+		})
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
-	"hash/fnv"
 	"math"
 	"reflect"
 	"strconv"
@@ -251,6 +250,27 @@ func SequenceAsList(vm *VM, target, locals *Object, msg *Message) *Object {
 	}
 }
 
+// SequenceAsReversed is a Sequence method.
+//
+// asReversed returns a new immutable sequence with the same encoding as the
+// receiver and its elements in reverse order. Reversal is by element, i.e. by
+// code unit for text encodings, not by character or grapheme cluster, so
+// reversing multibyte text can produce invalid code sequences.
+func SequenceAsReversed(vm *VM, target, locals *Object, msg *Message) *Object {
+	s := holdSeq(target)
+	sv := reflect.ValueOf(s.Value)
+	n := sv.Len()
+	v := reflect.MakeSlice(sv.Type(), n, n)
+	reflect.Copy(v, sv)
+	code := s.Code
+	unholdSeq(s.Mutable, target)
+	swap := reflect.Swapper(v.Interface())
+	for i := 0; i < n/2; i++ {
+		swap(i, n-i-1)
+	}
+	return vm.NewSequence(v.Interface(), false, code)
+}
+
 // SequenceAsStruct is a Sequence method.
 //
 // asStruct reinterprets a sequence as a packed binary structure described by
@@ -889,15 +909,84 @@ func SequenceForeach(vm *VM, target, locals *Object, msg *Message) (result *Obje
 	return result
 }
 
+// SequenceFromRunLength is a Sequence method.
+//
+// fromRunLength creates a mutable number-encoded Sequence from a list of
+// [value, count] pairs as produced by runLengthEncode, with the given item
+// type, which defaults to float64.
+//
+//   io> Sequence fromRunLength(list(list(1, 3), list(0, 2))) asList
+//   list(1, 1, 1, 0, 0)
+func SequenceFromRunLength(vm *VM, target, locals *Object, msg *Message) *Object {
+	l, obj, stop := msg.ListArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(obj, stop)
+	}
+	kind := SeqF64
+	if msg.ArgCount() > 1 {
+		k, exc, stop := msg.StringArgAt(vm, locals, 1)
+		if stop != NoStop {
+			return vm.Stop(exc, stop)
+		}
+		var ok bool
+		kind, ok = SeqKindNamed(k)
+		if !ok {
+			return vm.RaiseExceptionf("invalid item type name %q", k)
+		}
+	}
+	obj.Lock()
+	l = append([]*Object(nil), l...)
+	obj.Unlock()
+	t := kind.kind.Elem()
+	vals := make([]reflect.Value, len(l))
+	counts := make([]int, len(l))
+	total := 0
+	for i, p := range l {
+		p.Lock()
+		r, ok := p.Value.([]*Object)
+		if ok {
+			r = append([]*Object(nil), r...)
+		}
+		p.Unlock()
+		if !ok || len(r) != 2 {
+			return vm.RaiseExceptionf("item %d of list must be a List of value and count", i)
+		}
+		x, ok := r[0].Value.(float64)
+		if !ok {
+			return vm.RaiseExceptionf("value of item %d must be Number, not %s", i, vm.TypeName(r[0]))
+		}
+		n, ok := r[1].Value.(float64)
+		if !ok {
+			return vm.RaiseExceptionf("count of item %d must be Number, not %s", i, vm.TypeName(r[1]))
+		}
+		k, err := countArg(n, fmt.Sprintf("count of item %d", i))
+		if err != nil {
+			return vm.IoError(err)
+		}
+		if k > math.MaxInt32-total {
+			return vm.RaiseExceptionf("run-length decoded sequence would be too large")
+		}
+		vals[i] = reflect.ValueOf(x).Convert(t)
+		counts[i] = k
+		total += k
+	}
+	v := reflect.MakeSlice(kind.kind, total, total)
+	j := 0
+	for i, e := range vals {
+		for k := 0; k < counts[i]; k++ {
+			v.Index(j).Set(e)
+			j++
+		}
+	}
+	return vm.SequenceObject(Sequence{Value: v.Interface(), Mutable: true, Code: "number"})
+}
+
 // SequenceHash is a Sequence method.
 //
-// hash returns a hash of the sequence as a number.
+// hash returns a hash of the sequence as a number. Sequences which are equal
+// have equal hashes, regardless of their encodings and item types.
 func SequenceHash(vm *VM, target, locals *Object, msg *Message) *Object {
-	h := fnv.New32()
-	s := holdSeq(target)
-	h.Write(s.Bytes())
-	unholdSeq(s.Mutable, target)
-	return vm.NewNumber(float64(uint64(h.Sum32()) << 2)) // ????????
+	return vm.hashNumber(vm.HashObject(target))
 }
 
 // SequenceInSlice is a Sequence method.
@@ -1033,9 +1122,18 @@ func SequenceIsZero(vm *VM, target, locals *Object, msg *Message) *Object {
 // SequenceOccurrencesOfSeq is a Sequence method.
 //
 // occurrencesOfSeq counts the number of non-overlapping occurrences of the
-// given sequence in the receiver. Raises an exception if the argument is an
-// empty sequence.
+// given sequence in the receiver. If the optional second argument is true,
+// overlapping occurrences are counted instead, so "aaaa" occurrencesOfSeq("aa",
+// true) is 3. Raises an exception if the argument is an empty sequence.
 func SequenceOccurrencesOfSeq(vm *VM, target, locals *Object, msg *Message) *Object {
+	overlap := false
+	if msg.ArgCount() > 1 {
+		r, stop := msg.EvalArgAt(vm, locals, 1)
+		if stop != NoStop {
+			return vm.Stop(r, stop)
+		}
+		overlap = vm.AsBool(r)
+	}
 	s := holdSeq(target)
 	other, obj, stop := msg.SequenceArgAt(vm, locals, 0)
 	if stop != NoStop {
@@ -1052,8 +1150,12 @@ func SequenceOccurrencesOfSeq(vm *VM, target, locals *Object, msg *Message) *Obj
 		}
 		return vm.RaiseExceptionf("cannot count occurrences of empty sequence")
 	}
+	step := ol
+	if overlap {
+		step = 1
+	}
 	n := 0
-	for k := s.Find(other, 0); k >= 0; k = s.Find(other, k+ol) {
+	for k := s.Find(other, 0); k >= 0; k = s.Find(other, k+step) {
 		n++
 	}
 	unholdSeq(s.Mutable, target)
@@ -1219,6 +1321,51 @@ func SequencePack(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.NewSequence(b, true, "number")
 }
 
+// SequenceRepeated is a Sequence method.
+//
+// repeated returns a new mutable sequence containing the receiver's elements
+// repeated the given number of times, with the same encoding and item type.
+//
+//   io> "ab" repeated(3)
+//   ababab
+func SequenceRepeated(vm *VM, target, locals *Object, msg *Message) *Object {
+	n, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	k, err := countArg(n, "repeat count")
+	if err != nil {
+		return vm.IoError(err)
+	}
+	s := holdSeq(target)
+	defer unholdSeq(s.Mutable, target)
+	if v, ok := s.Value.([]byte); ok {
+		if len(v) > 0 && k > math.MaxInt32/len(v) {
+			return vm.RaiseExceptionf("repeated sequence would be too large")
+		}
+		return vm.SequenceObject(Sequence{Value: bytes.Repeat(v, k), Mutable: true, Code: s.Code})
+	}
+	sv := reflect.ValueOf(s.Value)
+	l := sv.Len()
+	if l > 0 && k > math.MaxInt32/l {
+		return vm.RaiseExceptionf("repeated sequence would be too large")
+	}
+	v := reflect.MakeSlice(sv.Type(), k*l, k*l)
+	for i := 0; i < k; i++ {
+		reflect.Copy(v.Slice(i*l, (i+1)*l), sv)
+	}
+	return vm.SequenceObject(Sequence{Value: v.Interface(), Mutable: true, Code: s.Code})
+}
+
+// countArg converts v to a count of items for building a sequence, returning
+// an error if v is not a finite nonnegative number small enough to allocate.
+func countArg(v float64, name string) (int, error) {
+	if !(v >= 0 && v <= math.MaxInt32) {
+		return 0, fmt.Errorf("%s must be a nonnegative number no greater than %d, not %v", name, math.MaxInt32, v)
+	}
+	return int(v), nil
+}
+
 // SequenceReverseFindSeq is a Sequence method.
 //
 // reverseFindSeq locates the last occurrence of the argument sequence in the
@@ -1263,6 +1410,66 @@ func SequenceReverseFindSeq(vm *VM, target, locals *Object, msg *Message) *Objec
 	return vm.Nil
 }
 
+// SequenceRunLengthEncode is a Sequence method.
+//
+// runLengthEncode returns a list of [value, count] pairs describing each run
+// of equal consecutive values in the sequence, in order. Values are Numbers,
+// as from at. Sequence fromRunLength is the inverse.
+//
+//   io> list(1, 1, 1, 0, 0) asSequence runLengthEncode
+//   list(list(1, 3), list(0, 2))
+func SequenceRunLengthEncode(vm *VM, target, locals *Object, msg *Message) *Object {
+	s := holdSeq(target)
+	var runs [][2]float64
+	for i := 0; ; i++ {
+		x, ok := s.At(i)
+		if !ok {
+			break
+		}
+		if n := len(runs) - 1; n >= 0 && runs[n][0] == x {
+			runs[n][1]++
+		} else {
+			runs = append(runs, [2]float64{x, 1})
+		}
+	}
+	unholdSeq(s.Mutable, target)
+	l := make([]*Object, len(runs))
+	for i, r := range runs {
+		l[i] = vm.NewList(vm.NewNumber(r[0]), vm.NewNumber(r[1]))
+	}
+	return vm.NewList(l...)
+}
+
+// SequenceSample is a Sequence method.
+//
+// sample returns a new immutable sequence of n elements of the receiver chosen
+// uniformly at random without replacement, drawing from the VM's global random
+// source. It is an error for n to exceed the size of the receiver.
+func SequenceSample(vm *VM, target, locals *Object, msg *Message) *Object {
+	arg, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	s := holdSeq(target)
+	sv := reflect.ValueOf(s.Value)
+	l := sv.Len()
+	v := reflect.MakeSlice(sv.Type(), l, l)
+	reflect.Copy(v, sv)
+	code := s.Code
+	unholdSeq(s.Mutable, target)
+	if arg < 0 || arg != math.Trunc(arg) || arg > float64(l) {
+		return vm.RaiseExceptionf("sample size must be an integer between 0 and %d, not %v", l, arg)
+	}
+	n := int(arg)
+	// Partial Fisher-Yates: the first n elements of the copy become the
+	// sample.
+	swap := reflect.Swapper(v.Interface())
+	for i := 0; i < n; i++ {
+		swap(i, i+vm.rand.Intn(l-i))
+	}
+	return vm.SequenceObject(Sequence{Value: v.Slice(0, n).Interface(), Mutable: false, Code: code})
+}
+
 // SequenceSplitAt is a Sequence method.
 //
 // splitAt splits the sequence at the given index.
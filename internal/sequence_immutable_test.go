@@ -0,0 +1,90 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/zephyrtronium/iolang"
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+// TestSequenceImmutableMethods tests non-mutating Sequence methods.
+func TestSequenceImmutableMethods(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]map[string]testutils.SourceTestCase{
+		"asReversed": {
+			"string":    {Source: `"abc" asReversed`, Pass: testutils.PassEqual(vm.NewString("cba"))},
+			"single":    {Source: `"a" asReversed`, Pass: testutils.PassEqual(vm.NewString("a"))},
+			"even":      {Source: `"abcd" asReversed`, Pass: testutils.PassEqual(vm.NewString("dcba"))},
+			"empty":     {Source: `"" asReversed`, Pass: testutils.PassEqual(vm.NewString(""))},
+			"immutable": {Source: `"abc" asMutable asReversed isMutable`, Pass: testutils.PassIdentical(vm.False)},
+			"unchanged": {Source: `Object clone do(s := "abc" asMutable; s asReversed; r := s) r`, Pass: testutils.PassEqual(vm.NewString("abc"))},
+			"encoding":  {Source: `"ab" asUTF16 asReversed encoding`, Pass: testutils.PassEqual(vm.NewString("utf16"))},
+			"numbers":   {Source: `list(1, 2, 3) asSequence("int32") asReversed == list(3, 2, 1) asSequence("int32")`, Pass: testutils.PassIdentical(vm.True)},
+			"twice":     {Source: `"h\u00e9llo" asReversed asReversed`, Pass: testutils.PassEqual(vm.NewString("h\u00e9llo"))},
+		},
+		"fromRunLength": {
+			"basic":     {Source: `Sequence fromRunLength(list(list(1, 3), list(0, 2)), "uint8") == list(1, 1, 1, 0, 0) asSequence("uint8")`, Pass: testutils.PassIdentical(vm.True)},
+			"itemType":  {Source: `Sequence fromRunLength(list(list(1, 3)), "int16") itemType`, Pass: testutils.PassEqual(vm.NewString("int16"))},
+			"default":   {Source: `Sequence fromRunLength(list(list(0.5, 2))) asList`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(0.5), vm.NewNumber(0.5)))},
+			"empty":     {Source: `Sequence fromRunLength(list) size`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"roundTrip": {Source: `Sequence fromRunLength(list(5, 5, 2, 5) asSequence("int32") runLengthEncode, "int32") == list(5, 5, 2, 5) asSequence("int32")`, Pass: testutils.PassIdentical(vm.True)},
+			"badPair":   {Source: `Sequence fromRunLength(list(list(1)))`, Pass: testutils.PassFailure()},
+			"badValue":  {Source: `Sequence fromRunLength(list(list("a", 1)))`, Pass: testutils.PassFailure()},
+			"negative":  {Source: `Sequence fromRunLength(list(list(1, -1)))`, Pass: testutils.PassFailure()},
+			"badCount":  {Source: `Sequence fromRunLength(list(list(1, "a")))`, Pass: testutils.PassFailure()},
+			"nan":       {Source: `Sequence fromRunLength(list(list(1, Number constants nan)))`, Pass: testutils.PassFailure()},
+			"inf":       {Source: `Sequence fromRunLength(list(list(1, 1 / 0)))`, Pass: testutils.PassFailure()},
+			"huge":      {Source: `Sequence fromRunLength(list(list(1, 2 ** 64)))`, Pass: testutils.PassFailure()},
+			"hugeTotal": {Source: `Sequence fromRunLength(list(list(1, 2 ** 31 - 1), list(0, 2 ** 31 - 1)), "uint8")`, Pass: testutils.PassFailure()},
+			"zero":      {Source: `Sequence fromRunLength(list(list(1, 0), list(2, 1))) asList`, Pass: testutils.PassEqual(vm.NewList(vm.NewNumber(2)))},
+			"badType":   {Source: `Sequence fromRunLength(list, "nope")`, Pass: testutils.PassFailure()},
+		},
+		"occurrencesOfSeq": {
+			"default":        {Source: `"aaaa" occurrencesOfSeq("aa")`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"nonOverlapping": {Source: `"aaaa" occurrencesOfSeq("aa", false)`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"overlapping":    {Source: `"aaaa" occurrencesOfSeq("aa", true)`, Pass: testutils.PassEqual(vm.NewNumber(3))},
+			"overlapOdd":     {Source: `"aaa" occurrencesOfSeq("aa", true)`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"single":         {Source: `"aaaa" occurrencesOfSeq("a", true)`, Pass: testutils.PassEqual(vm.NewNumber(4))},
+			"none":           {Source: `"aaaa" occurrencesOfSeq("b", true)`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"empty":          {Source: `"aaaa" occurrencesOfSeq("", true)`, Pass: testutils.PassFailure()},
+			"continue":       {Source: `"aaaa" occurrencesOfSeq("a", continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+		},
+		"repeated": {
+			"string":    {Source: `"ab" repeated(3)`, Pass: testutils.PassEqual(vm.NewString("ababab"))},
+			"zero":      {Source: `"ab" repeated(0) size`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"empty":     {Source: `"" repeated(5) size`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"encoding":  {Source: `"\u00e9" asUTF16 repeated(2) encoding`, Pass: testutils.PassEqual(vm.NewString("utf16"))},
+			"wide":      {Source: `list(1, 2) asSequence("int32") repeated(2) == list(1, 2, 1, 2) asSequence("int32")`, Pass: testutils.PassIdentical(vm.True)},
+			"itemType":  {Source: `list(1.5) asSequence repeated(3) itemType`, Pass: testutils.PassEqual(vm.NewString("float64"))},
+			"negative":  {Source: `"ab" repeated(-1)`, Pass: testutils.PassFailure()},
+			"huge":      {Source: `"ab" repeated(2 ** 62)`, Pass: testutils.PassFailure()},
+			"emptyHuge": {Source: `"" repeated(2 ** 64)`, Pass: testutils.PassFailure()},
+			"emptyInf":  {Source: `"" repeated(1 / 0)`, Pass: testutils.PassFailure()},
+			"nan":       {Source: `"ab" repeated(Number constants nan)`, Pass: testutils.PassFailure()},
+		},
+		"sample": {
+			"size":      {Source: `list(1, 2, 3, 4) asSequence sample(2) size`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"all":       {Source: `list(4, 2, 3, 1) asSequence sample(4) asMutable sort == list(1, 2, 3, 4) asSequence`, Pass: testutils.PassIdentical(vm.True)},
+			"distinct":  {Source: `Object clone do(s := list(1, 2, 4, 8, 16) asSequence sample(3); r := s sum == s asList map(v, v) reduce(|, 0)) r`, Pass: testutils.PassIdentical(vm.True)},
+			"zero":      {Source: `"abc" sample(0) size`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"itemType":  {Source: `list(1, 2) asSequence("int32") sample(1) itemType`, Pass: testutils.PassEqual(vm.NewString("int32"))},
+			"immutable": {Source: `"abc" asMutable sample(2) isMutable`, Pass: testutils.PassIdentical(vm.False)},
+			"receiver":  {Source: `Object clone do(s := "abcdef" asMutable; s sample(6); r := s) r`, Pass: testutils.PassEqual(vm.NewString("abcdef"))},
+			"tooMany":   {Source: `"abc" sample(4)`, Pass: testutils.PassFailure()},
+			"negative":  {Source: `"abc" sample(-1)`, Pass: testutils.PassFailure()},
+		},
+		"runLengthEncode": {
+			"numbers": {Source: `list(1, 1, 1, 0, 0) asSequence runLengthEncode`, Pass: testutils.PassEqual(vm.NewList(vm.NewList(vm.NewNumber(1), vm.NewNumber(3)), vm.NewList(vm.NewNumber(0), vm.NewNumber(2))))},
+			"string":  {Source: `"aab" runLengthEncode`, Pass: testutils.PassEqual(vm.NewList(vm.NewList(vm.NewNumber(97), vm.NewNumber(2)), vm.NewList(vm.NewNumber(98), vm.NewNumber(1))))},
+			"single":  {Source: `list(4) asSequence runLengthEncode`, Pass: testutils.PassEqual(vm.NewList(vm.NewList(vm.NewNumber(4), vm.NewNumber(1))))},
+			"empty":   {Source: `"" runLengthEncode`, Pass: testutils.PassEqual(vm.NewList())},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			for name, s := range c {
+				t.Run(name, s.TestFunc("TestSequenceImmutableMethods"))
+			}
+		})
+	}
+}
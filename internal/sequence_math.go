@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math"
 	"math/bits"
+	"sort"
 )
 
 // CheckNumeric checks that the sequence is numeric, optionally requiring the
@@ -1000,6 +1001,61 @@ func SequenceProduct(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.NewNumber(x)
 }
 
+// SequenceSampleIndex is a Sequence method.
+//
+// sampleIndex returns an index into the sequence chosen with probability
+// proportional to the element at that index. If an argument is given, it is a
+// random number generator whose value method returns a uniform draw in [0, 1),
+// such as a Random; otherwise, the VM's global generator is used; see System
+// setRandomSeed. It is an error for any weight to be negative or for all
+// weights to be zero.
+func SequenceSampleIndex(vm *VM, target, locals *Object, msg *Message) *Object {
+	var u float64
+	if msg.ArgCount() > 0 {
+		r, stop := msg.EvalArgAt(vm, locals, 0)
+		if stop != NoStop {
+			return vm.Stop(r, stop)
+		}
+		v, stop := vm.Perform(r, locals, vm.IdentMessage("value"))
+		if stop != NoStop {
+			return vm.Stop(v, stop)
+		}
+		x, ok := v.Value.(float64)
+		if !ok || x < 0 || x >= 1 {
+			return vm.RaiseExceptionf("random value must be a Number in [0, 1), not %s", vm.AsString(v))
+		}
+		u = x
+	} else {
+		u = vm.rand.Float64()
+	}
+	s := holdSeq(target)
+	n := s.Len()
+	cum := make([]float64, n)
+	t := 0.0
+	for i := 0; i < n; i++ {
+		x, _ := s.At(i)
+		if x < 0 || math.IsNaN(x) {
+			unholdSeq(s.Mutable, target)
+			return vm.RaiseExceptionf("weight %d is %v", i, x)
+		}
+		t += x
+		cum[i] = t
+	}
+	unholdSeq(s.Mutable, target)
+	if t == 0 {
+		return vm.RaiseExceptionf("sampleIndex requires a positive weight")
+	}
+	x := u * t
+	k := sort.Search(n, func(i int) bool { return cum[i] > x })
+	if k == n {
+		// Rounding put the draw at the very end. Choose the last index with
+		// nonzero weight.
+		for k--; k > 0 && cum[k] == cum[k-1]; k-- {
+		}
+	}
+	return vm.NewNumber(float64(k))
+}
+
 // SequenceSin is a Sequence method.
 //
 // sin sets each element of the receiver to its sine.
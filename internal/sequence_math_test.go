@@ -0,0 +1,32 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+// TestSequenceMathMethods tests Sequence numeric methods.
+func TestSequenceMathMethods(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]map[string]testutils.SourceTestCase{
+		"sampleIndex": {
+			"low":      {Source: `list(1, 2, 1) asSequence sampleIndex(Object clone do(value := 0))`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"middle":   {Source: `list(1, 2, 1) asSequence sampleIndex(Object clone do(value := 0.5))`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"high":     {Source: `list(1, 2, 1) asSequence sampleIndex(Object clone do(value := 0.99))`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"zeroSkip": {Source: `list(0, 1, 0) asSequence sampleIndex(Object clone do(value := 0))`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"default":  {Source: `list(0, 0, 1) asSequence sampleIndex`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"negative": {Source: `list(1, -1) asSequence sampleIndex`, Pass: testutils.PassFailure()},
+			"allZero":  {Source: `list(0, 0) asSequence sampleIndex`, Pass: testutils.PassFailure()},
+			"empty":    {Source: `list asSequence sampleIndex`, Pass: testutils.PassFailure()},
+			"badDraw":  {Source: `list(1) asSequence sampleIndex(Object clone do(value := 1))`, Pass: testutils.PassFailure()},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			for name, s := range c {
+				t.Run(name, s.TestFunc("TestSequenceMathMethods"))
+			}
+		})
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
-	"strings"
 )
 
 // CheckMutable returns an error if the sequence is not mutable, or nil
@@ -212,29 +211,8 @@ func SequenceSetItemType(vm *VM, target, locals *Object, msg *Message) *Object {
 	if stop != NoStop {
 		return vm.Stop(exc, stop)
 	}
-	var kind SeqKind
-	switch strings.ToLower(k) {
-	case "uint8":
-		kind = SeqU8
-	case "uint16":
-		kind = SeqU16
-	case "uint32":
-		kind = SeqU32
-	case "uint64":
-		kind = SeqU64
-	case "int8":
-		kind = SeqS8
-	case "int16":
-		kind = SeqS16
-	case "int32":
-		kind = SeqS32
-	case "int64":
-		kind = SeqS64
-	case "float32":
-		kind = SeqF32
-	case "float64":
-		kind = SeqF64
-	default:
+	kind, ok := SeqKindNamed(k)
+	if !ok {
 		return vm.RaiseExceptionf("invalid item type name %q", k)
 	}
 	target.Value = vm.SequenceFromBytes(s.Bytes(), kind)
@@ -364,29 +342,8 @@ func SequenceConvertToItemType(vm *VM, target, locals *Object, msg *Message) *Ob
 	if stop != NoStop {
 		return vm.Stop(exc, stop)
 	}
-	var kind SeqKind
-	switch strings.ToLower(k) {
-	case "uint8":
-		kind = SeqU8
-	case "uint16":
-		kind = SeqU16
-	case "uint32":
-		kind = SeqU32
-	case "uint64":
-		kind = SeqU64
-	case "int8":
-		kind = SeqS8
-	case "int16":
-		kind = SeqS16
-	case "int32":
-		kind = SeqS32
-	case "int64":
-		kind = SeqS64
-	case "float32":
-		kind = SeqF32
-	case "float64":
-		kind = SeqF64
-	default:
+	kind, ok := SeqKindNamed(k)
+	if !ok {
 		return vm.RaiseExceptionf("invalid item type name %q", k)
 	}
 	target.Value = s.Convert(kind)
@@ -862,6 +819,43 @@ func SequenceLeaveThenRemove(vm *VM, target, locals *Object, msg *Message) *Obje
 	return target
 }
 
+// SequencePadToItemSize is a Sequence method.
+//
+// padToItemSize appends zeros to the sequence so that its size in bytes is a
+// multiple of the item size of the given type, which may be any type accepted
+// by setItemType. This prevents setItemType from dropping trailing bytes that
+// do not fill an item.
+//
+//   io> "abc" asMutable padToItemSize("uint16") setItemType("uint16") size
+//   2
+func SequencePadToItemSize(vm *VM, target, locals *Object, msg *Message) *Object {
+	k, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	kind, ok := SeqKindNamed(k)
+	if !ok {
+		return vm.RaiseExceptionf("invalid item type name %q", k)
+	}
+	s := lockSeq(target)
+	defer target.Unlock()
+	if err := s.CheckMutable("padToItemSize"); err != nil {
+		return vm.IoError(err)
+	}
+	size := kind.ItemSize()
+	n := s.Len() * s.ItemSize()
+	if n%size == 0 {
+		return target
+	}
+	// Item sizes are powers of two, so the padding is always a whole number
+	// of the receiver's items.
+	pad := (size - n%size) / s.ItemSize()
+	v := reflect.ValueOf(s.Value)
+	s.Value = reflect.AppendSlice(v, reflect.MakeSlice(v.Type(), pad, pad)).Interface()
+	target.Value = s
+	return target
+}
+
 // SequencePreallocateToSize is a Sequence method.
 //
 // preallocateToSize ensures that the receiver can grow to be at least n bytes
@@ -1384,6 +1378,93 @@ func SequenceReplaceSeq(vm *VM, target, locals *Object, msg *Message) *Object {
 	return target
 }
 
+// SequenceReplaceSeqWith is a Sequence method.
+//
+// replaceSeqWith replaces each instance of a search sequence with the result
+// of calling a block with the match. Text inserted by a replacement is not
+// searched again. If the block modifies the receiver so that the match is no
+// longer in place, replacement stops. For example:
+//
+//   io> "a1b22" asMutable replaceSeqWith("2", block(m, m .. m))
+//   a1b2222
+func SequenceReplaceSeqWith(vm *VM, target, locals *Object, msg *Message) *Object {
+	s := lockSeq(target)
+	err := s.CheckMutable("replaceSeqWith")
+	target.Unlock()
+	if err != nil {
+		return vm.IoError(err)
+	}
+	search, sobj, stop := msg.SequenceArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(sobj, stop)
+	}
+	if search.IsMutable() {
+		// Copy the search sequence so that we needn't hold its lock while
+		// calling the block.
+		sobj.Lock()
+		sv := reflect.ValueOf(search.Value)
+		v := reflect.MakeSlice(sv.Type(), sv.Len(), sv.Len())
+		reflect.Copy(v, sv)
+		search = Sequence{Value: v.Interface(), Mutable: true, Code: search.Code}
+		sobj.Unlock()
+	}
+	sl := search.Len()
+	if sl == 0 {
+		return vm.RaiseExceptionf("cannot replace length 0 sequence")
+	}
+	blk, stop := msg.EvalArgAt(vm, locals, 1)
+	if stop != NoStop {
+		return vm.Stop(blk, stop)
+	}
+	if blk.Tag() != BlockTag {
+		return vm.RaiseExceptionf("argument 1 to Sequence replaceSeqWith must be Block, not %s", vm.TypeName(blk))
+	}
+	m := vm.IdentMessage("", vm.IdentMessage(""))
+	k := 0
+	for {
+		s = lockSeq(target)
+		k = s.Find(search, k)
+		if k < 0 || k+sl > s.Len() {
+			target.Unlock()
+			return target
+		}
+		sv := reflect.ValueOf(s.Value)
+		v := reflect.MakeSlice(sv.Type(), sl, sl)
+		reflect.Copy(v, sv.Slice(k, k+sl))
+		code := s.Code
+		target.Unlock()
+		m.Args[0].Memo = vm.NewSequence(v.Interface(), false, code)
+		r := vm.ActivateBlock(blk, locals, locals, locals, m)
+		if r, stop := vm.Status(r); stop != NoStop {
+			return vm.Stop(r, stop)
+		}
+		r.Lock()
+		repl, ok := r.Value.(Sequence)
+		if !ok {
+			r.Unlock()
+			return vm.RaiseExceptionf("replaceSeqWith block must return Sequence, not %s", vm.TypeName(r))
+		}
+		if repl.IsMutable() {
+			// Copy the replacement so that we hold only one lock at a time.
+			rv := reflect.ValueOf(repl.Value)
+			v := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+			reflect.Copy(v, rv)
+			repl.Value = v.Interface()
+		}
+		r.Unlock()
+		rl := repl.Len()
+		s = lockSeq(target)
+		if s.Find(search, k) != k {
+			// The block moved or removed the match.
+			target.Unlock()
+			return target
+		}
+		target.Value = s.Remove(k, k+sl).Insert(repl, k)
+		target.Unlock()
+		k += rl
+	}
+}
+
 // SequenceReverseInPlace is a Sequence method.
 //
 // reverseInPlace reverses the elements of the sequence.
@@ -1528,6 +1609,23 @@ func SequenceSetSize(vm *VM, target, locals *Object, msg *Message) *Object {
 	return target
 }
 
+// SequenceShuffle is a Sequence method.
+//
+// shuffle randomly permutes the elements of the sequence in place, drawing
+// from the VM's global random source.
+func SequenceShuffle(vm *VM, target, locals *Object, msg *Message) *Object {
+	s := lockSeq(target)
+	defer target.Unlock()
+	if err := s.CheckMutable("shuffle"); err != nil {
+		return vm.IoError(err)
+	}
+	swap := reflect.Swapper(s.Value)
+	for i := s.Len() - 1; i > 0; i-- {
+		swap(i, vm.rand.Intn(i+1))
+	}
+	return target
+}
+
 // SequenceSort is a Sequence method.
 //
 // sort sorts the elements of the sequence.
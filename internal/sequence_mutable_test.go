@@ -0,0 +1,56 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+// TestSequenceMutableMethods tests Sequence methods which modify the receiver.
+func TestSequenceMutableMethods(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]map[string]testutils.SourceTestCase{
+		"padToItemSize": {
+			"unpadded":  {Source: `"abc" asMutable setItemType("uint16") size`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"padded":    {Source: `"abc" asMutable padToItemSize("uint16") setItemType("uint16") size`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"zeroFill":  {Source: `"abc" asMutable padToItemSize("uint16") setItemType("uint16") at(1)`, Pass: testutils.PassEqual(vm.NewNumber('c'))},
+			"aligned":   {Source: `"abcd" asMutable padToItemSize("uint32") size`, Pass: testutils.PassEqual(vm.NewNumber(4))},
+			"wideItems": {Source: `"abcdef" asMutable setItemType("uint16") padToItemSize("uint64") size`, Pass: testutils.PassEqual(vm.NewNumber(4))},
+			"empty":     {Source: `"" asMutable padToItemSize("float64") size`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"badType":   {Source: `"abc" asMutable padToItemSize("uint7")`, Pass: testutils.PassFailure()},
+			"immutable": {Source: `"abc" padToItemSize("uint16")`, Pass: testutils.PassFailure()},
+		},
+		"replaceSeqWith": {
+			"basic":       {Source: `"a1b22" asMutable replaceSeqWith("2", block(m, m .. m))`, Pass: testutils.PassEqual(vm.NewString("a1b2222"))},
+			"none":        {Source: `"abc" asMutable replaceSeqWith("x", block(m, "y"))`, Pass: testutils.PassEqual(vm.NewString("abc"))},
+			"containing":  {Source: `"aba" asMutable replaceSeqWith("a", block(m, "aa"))`, Pass: testutils.PassEqual(vm.NewString("aabaa"))},
+			"overlapping": {Source: `"aaaa" asMutable replaceSeqWith("aaa", block(m, "X"))`, Pass: testutils.PassEqual(vm.NewString("Xa"))},
+			"adjacent":    {Source: `"aaaa" asMutable replaceSeqWith("aa", block(m, "X"))`, Pass: testutils.PassEqual(vm.NewString("XX"))},
+			"shrink":      {Source: `"xaxbx" asMutable replaceSeqWith("x", block(m, ""))`, Pass: testutils.PassEqual(vm.NewString("ab"))},
+			"match":       {Source: `Object clone do(r := list; "abab" asMutable replaceSeqWith("b", block(m, r append(m); m))) r`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("b"), vm.NewString("b")))},
+			"inPlace":     {Source: `Object clone do(s := "abc" asMutable; r := s replaceSeqWith("b", block(m, "B")) isIdenticalTo(s)) r`, Pass: testutils.PassIdentical(vm.True)},
+			"receiver":    {Source: `Object clone do(s := "abc" asMutable; s replaceSeqWith("b", block(m, "B")); r := s) r`, Pass: testutils.PassEqual(vm.NewString("aBc"))},
+			"mutableArg":  {Source: `"abc" asMutable replaceSeqWith("b" asMutable, block(m, "B"))`, Pass: testutils.PassEqual(vm.NewString("aBc"))},
+			"removed":     {Source: `Object clone do(s := "abcb" asMutable; s replaceSeqWith("b", block(m, s atPut(1, 120); "B")); r := s) r`, Pass: testutils.PassEqual(vm.NewString("axcb"))},
+			"moved":       {Source: `Object clone do(s := "abcb" asMutable; s replaceSeqWith("b", block(m, s atInsertSeq(0, "z"); "B")); r := s) r`, Pass: testutils.PassEqual(vm.NewString("zabcb"))},
+			"empty":       {Source: `"abc" asMutable replaceSeqWith("", block(m, "x"))`, Pass: testutils.PassFailure()},
+			"immutable":   {Source: `"abc" replaceSeqWith("b", block(m, "B"))`, Pass: testutils.PassFailure()},
+			"notBlock":    {Source: `"abc" asMutable replaceSeqWith("b", "B")`, Pass: testutils.PassFailure()},
+			"notSeq":      {Source: `"abc" asMutable replaceSeqWith("b", block(m, 1))`, Pass: testutils.PassFailure()},
+			"badSearch":   {Source: `"abc" asMutable replaceSeqWith(1, block(m, "B"))`, Pass: testutils.PassFailure()},
+		},
+		"shuffle": {
+			"permutation": {Source: `list(5, 3, 1, 4, 2) asSequence("int16") shuffle sort == list(1, 2, 3, 4, 5) asSequence("int16")`, Pass: testutils.PassIdentical(vm.True)},
+			"receiver":    {Source: `Object clone do(s := "abc" asMutable; r := s shuffle isIdenticalTo(s)) r`, Pass: testutils.PassIdentical(vm.True)},
+			"empty":       {Source: `"" asMutable shuffle size`, Pass: testutils.PassEqual(vm.NewNumber(0))},
+			"immutable":   {Source: `"abc" shuffle`, Pass: testutils.PassFailure()},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			for name, s := range c {
+				t.Run(name, s.TestFunc("TestSequenceMutableMethods"))
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package internal
+
+import (
+	"regexp"
+	"sync"
+)
+
+// regexpCacheSize is the maximum number of compiled patterns a VM keeps.
+const regexpCacheSize = 128
+
+// regexpCache holds compiled regular expressions keyed by their patterns so
+// that methods used in loops need not recompile them. Once it is full, it is
+// emptied before adding a new pattern.
+type regexpCache struct {
+	mu sync.Mutex
+	m  map[string]*regexp.Regexp
+}
+
+// Regexp compiles a regular expression using Go's regexp syntax, reusing a
+// previous compilation of the same pattern if possible.
+func (vm *VM) Regexp(pattern string) (*regexp.Regexp, error) {
+	c := vm.regexps
+	c.mu.Lock()
+	re := c.m[pattern]
+	c.mu.Unlock()
+	if re != nil {
+		return re, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	if len(c.m) >= regexpCacheSize || c.m == nil {
+		c.m = make(map[string]*regexp.Regexp)
+	}
+	c.m[pattern] = re
+	c.mu.Unlock()
+	return re, nil
+}
+
+// regexArgs evaluates the pattern argument of a regex method and returns the
+// compiled pattern and the receiver's decoded string. If evaluating or
+// compiling the pattern fails, then the method should return r.
+func regexArgs(vm *VM, target, locals *Object, msg *Message) (re *regexp.Regexp, sv string, r *Object) {
+	pattern, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return nil, "", vm.Stop(exc, stop)
+	}
+	re, err := vm.Regexp(pattern)
+	if err != nil {
+		return nil, "", vm.IoError(err)
+	}
+	s := holdSeq(target)
+	sv = s.String()
+	unholdSeq(s.Mutable, target)
+	return re, sv, nil
+}
+
+// SequenceAllMatchesOfRegex is a Sequence method.
+//
+// allMatchesOfRegex returns a list of the successive non-overlapping
+// substrings of the receiver which match the regular expression given as the
+// argument, in Go's regexp syntax.
+//
+//   io> "a1 b22 c333" allMatchesOfRegex("[0-9]+")
+//   list(1, 22, 333)
+func SequenceAllMatchesOfRegex(vm *VM, target, locals *Object, msg *Message) *Object {
+	re, sv, r := regexArgs(vm, target, locals, msg)
+	if r != nil {
+		return r
+	}
+	m := re.FindAllString(sv, -1)
+	l := make([]*Object, len(m))
+	for i, x := range m {
+		l[i] = vm.NewString(x)
+	}
+	return vm.NewList(l...)
+}
+
+// SequenceCaptureGroups is a Sequence method.
+//
+// captureGroups returns a list with an entry for each successive
+// non-overlapping match of the regular expression given as the argument. Each
+// entry is a list of the text of the entire match followed by that of each
+// capture group, or nil for groups which did not participate in the match.
+//
+//   io> "k=v, x=" captureGroups("(\\w)=(\\w)?")
+//   list(list(k=v, k, v), list(x=, x, nil))
+func SequenceCaptureGroups(vm *VM, target, locals *Object, msg *Message) *Object {
+	re, sv, r := regexArgs(vm, target, locals, msg)
+	if r != nil {
+		return r
+	}
+	m := re.FindAllStringSubmatchIndex(sv, -1)
+	l := make([]*Object, len(m))
+	for i, x := range m {
+		g := make([]*Object, len(x)/2)
+		for j := range g {
+			if x[2*j] < 0 {
+				g[j] = vm.Nil
+				continue
+			}
+			g[j] = vm.NewString(sv[x[2*j]:x[2*j+1]])
+		}
+		l[i] = vm.NewList(g...)
+	}
+	return vm.NewList(l...)
+}
+
+// SequenceMatchesRegex is a Sequence method.
+//
+// matchesRegex returns whether any part of the receiver matches the regular
+// expression given as the argument, in Go's regexp syntax. Use ^ and $ to
+// require the entire sequence to match.
+func SequenceMatchesRegex(vm *VM, target, locals *Object, msg *Message) *Object {
+	re, sv, r := regexArgs(vm, target, locals, msg)
+	if r != nil {
+		return r
+	}
+	return vm.IoBool(re.MatchString(sv))
+}
+
+// SequenceReplaceRegex is a Sequence method.
+//
+// replaceRegex replaces all matches of the regular expression given as the
+// first argument with the second argument, in which $1 or ${name} stands for
+// the text of the corresponding capture group, as in Go's
+// Regexp.ReplaceAllString. The result is re-encoded with the sequence's
+// encoding and item type, so the number of items may change. If the encoded
+// result doesn't fill a whole number of items, it is padded with zero bytes.
+//
+//   io> "2024-06-01" asMutable replaceRegex("(\\d+)-(\\d+)-(\\d+)", "$3/$2/$1")
+//   01/06/2024
+func SequenceReplaceRegex(vm *VM, target, locals *Object, msg *Message) *Object {
+	pattern, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	repl, exc, stop := msg.StringArgAt(vm, locals, 1)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	re, err := vm.Regexp(pattern)
+	if err != nil {
+		return vm.IoError(err)
+	}
+	s := lockSeq(target)
+	defer target.Unlock()
+	if err := s.CheckMutable("replaceRegex"); err != nil {
+		return vm.IoError(err)
+	}
+	target.Value = EncodeString(re.ReplaceAllString(s.String(), repl), s.Code, s.Kind())
+	return target
+}
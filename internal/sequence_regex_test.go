@@ -0,0 +1,57 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/zephyrtronium/iolang"
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+// TestSequenceRegexMethods tests Sequence regular expression methods.
+func TestSequenceRegexMethods(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]map[string]testutils.SourceTestCase{
+		"allMatchesOfRegex": {
+			"digits":    {Source: `"a1 b22 c333" allMatchesOfRegex("[0-9]+")`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("1"), vm.NewString("22"), vm.NewString("333")))},
+			"none":      {Source: `"abc" allMatchesOfRegex("[0-9]+")`, Pass: testutils.PassEqual(vm.NewList())},
+			"encoding":  {Source: `"héllo wörld" asUTF16 allMatchesOfRegex("\\pL+")`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("héllo"), vm.NewString("wörld")))},
+			"bad":       {Source: `"abc" allMatchesOfRegex("(")`, Pass: testutils.PassFailure()},
+			"continue":  {Source: `"abc" allMatchesOfRegex(continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+			"exception": {Source: `"abc" allMatchesOfRegex(Exception raise)`, Pass: testutils.PassFailure()},
+		},
+		"captureGroups": {
+			"groups":   {Source: `"k=v, x=y" captureGroups("(\\w)=(\\w)")`, Pass: testutils.PassEqual(vm.NewList(vm.NewList(vm.NewString("k=v"), vm.NewString("k"), vm.NewString("v")), vm.NewList(vm.NewString("x=y"), vm.NewString("x"), vm.NewString("y"))))},
+			"optional": {Source: `"x=" captureGroups("(\\w)=(\\w)?")`, Pass: testutils.PassEqual(vm.NewList(vm.NewList(vm.NewString("x="), vm.NewString("x"), vm.Nil)))},
+			"noGroups": {Source: `"ab" captureGroups("b")`, Pass: testutils.PassEqual(vm.NewList(vm.NewList(vm.NewString("b"))))},
+			"none":     {Source: `"ab" captureGroups("c")`, Pass: testutils.PassEqual(vm.NewList())},
+			"bad":      {Source: `"abc" captureGroups("[")`, Pass: testutils.PassFailure()},
+		},
+		"matchesRegex": {
+			"anywhere": {Source: `"abc123" matchesRegex("[0-9]")`, Pass: testutils.PassIdentical(vm.True)},
+			"anchored": {Source: `"abc123" matchesRegex("^[0-9]+$")`, Pass: testutils.PassIdentical(vm.False)},
+			"mutable":  {Source: `"abc" asMutable matchesRegex("b")`, Pass: testutils.PassIdentical(vm.True)},
+			"cached":   {Source: `"abc" matchesRegex("b+") and "bbb" matchesRegex("b+")`, Pass: testutils.PassIdentical(vm.True)},
+			"bad":      {Source: `"abc" matchesRegex("a{2,1}")`, Pass: testutils.PassFailure()},
+			"notSeq":   {Source: `"abc" matchesRegex(1)`, Pass: testutils.PassFailure()},
+		},
+		"replaceRegex": {
+			"replace":   {Source: `"a1b22" asMutable replaceRegex("[0-9]+", "#")`, Pass: testutils.PassEqual(vm.NewString("a#b#"))},
+			"numbered":  {Source: `"2024-06-01" asMutable replaceRegex("(\\d+)-(\\d+)-(\\d+)", "$3/$2/$1")`, Pass: testutils.PassEqual(vm.NewString("01/06/2024"))},
+			"named":     {Source: `"k=v" asMutable replaceRegex("(?P<key>\\w)=(?P<val>\\w)", "${val}=${key}")`, Pass: testutils.PassEqual(vm.NewString("v=k"))},
+			"none":      {Source: `"abc" asMutable replaceRegex("x", "y")`, Pass: testutils.PassEqual(vm.NewString("abc"))},
+			"grow":      {Source: `"aé" asUTF16 asMutable replaceRegex("é", "💡x") asUTF8`, Pass: testutils.PassEqual(vm.NewString("a💡x"))},
+			"growSize":  {Source: `"aé" asUTF16 asMutable replaceRegex("é", "💡x") size`, Pass: testutils.PassEqual(vm.NewNumber(4))},
+			"shrink":    {Source: `"a💡b" asUTF16 asMutable replaceRegex("💡", "") size`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"immutable": {Source: `"abc" replaceRegex("b", "x")`, Pass: testutils.PassFailure()},
+			"bad":       {Source: `"abc" asMutable replaceRegex("(", "x")`, Pass: testutils.PassFailure()},
+			"continue":  {Source: `"abc" asMutable replaceRegex("b", continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			for name, s := range c {
+				t.Run(name, s.TestFunc("TestSequenceRegexMethods"))
+			}
+		})
+	}
+}
@@ -6,11 +6,13 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"html"
 	"io"
 	"math"
 	"net/url"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	unichr "unicode"
@@ -18,9 +20,11 @@ import (
 	"unicode/utf16"
 	"unicode/utf8"
 
+	"golang.org/x/text/cases"
 	"golang.org/x/text/encoding/charmap"
 	"golang.org/x/text/encoding/unicode"
 	"golang.org/x/text/encoding/unicode/utf32"
+	"golang.org/x/text/unicode/norm"
 )
 
 var (
@@ -666,6 +670,44 @@ func SequenceAsBase64(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.NewString(e + "\n")
 }
 
+// SequenceAsDisplayString is a Sequence method.
+//
+// asDisplayString returns a UTF-8 string which is safe to write to a terminal
+// or log. Invalid UTF-8 is replaced with U+FFFD. Non-printing characters, such
+// as control characters, are escaped as by escape, unless the optional
+// argument is false, in which case they are passed through unchanged.
+// Printable Unicode is always passed through.
+func SequenceAsDisplayString(vm *VM, target, locals *Object, msg *Message) *Object {
+	esc := true
+	if msg.ArgCount() > 0 {
+		r, stop := msg.EvalArgAt(vm, locals, 0)
+		if stop != NoStop {
+			return vm.Stop(r, stop)
+		}
+		esc = vm.AsBool(r)
+	}
+	s := holdSeq(target)
+	sv := s.String()
+	unholdSeq(s.Mutable, target)
+	return vm.NewString(displayString(sv, esc))
+}
+
+// displayString replaces invalid UTF-8 in s with U+FFFD and, if esc is true,
+// escapes non-printing characters.
+func displayString(s string, esc bool) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if esc && r != ' ' && !strconv.IsPrint(r) {
+			q := strconv.QuoteRune(r)
+			b.WriteString(q[1 : len(q)-1])
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
 // SequenceAsFixedSizeType is a Sequence method.
 //
 // asFixedSizeType creates a copy of the sequence encoded in the first of
@@ -691,6 +733,27 @@ func SequenceAsFixedSizeType(vm *VM, target, locals *Object, msg *Message) *Obje
 	panic("unreachable")
 }
 
+// SequenceAsGraphemes is a Sequence method.
+//
+// asGraphemes returns a list of the extended grapheme clusters of the
+// sequence, so that a letter with combining marks or a flag emoji is a single
+// element. Segmentation follows UAX #29 using the Unicode version of the Go
+// standard library; see Graphemes for details.
+//
+//   io> "e\u0301!" asGraphemes size
+//   2
+func SequenceAsGraphemes(vm *VM, target, locals *Object, msg *Message) *Object {
+	s := holdSeq(target)
+	sv := s.String()
+	unholdSeq(s.Mutable, target)
+	g := Graphemes(sv)
+	l := make([]*Object, len(g))
+	for i, x := range g {
+		l[i] = vm.NewString(x)
+	}
+	return vm.NewList(l...)
+}
+
 // SequenceAsIoPath is a Sequence method.
 //
 // asIoPath creates a sequence converting the receiver to Io's path convention.
@@ -756,17 +819,43 @@ func SequenceAsMessage(vm *VM, target, locals *Object, msg *Message) *Object {
 // asNumber parses the sequence as a numeric representation.
 func SequenceAsNumber(vm *VM, target, locals *Object, msg *Message) *Object {
 	s := holdSeq(target)
-	b := strings.TrimSpace(s.String())
+	b := s.String()
+	unholdSeq(s.Mutable, target)
+	x, err := parseNumber(b)
+	if err != nil {
+		return vm.IoError(err)
+	}
+	return vm.NewNumber(x)
+}
+
+// SequenceAsNumberOrNil is a Sequence method.
+//
+// asNumberOrNil parses the sequence as a numeric representation, returning nil
+// if it cannot be parsed.
+func SequenceAsNumberOrNil(vm *VM, target, locals *Object, msg *Message) *Object {
+	s := holdSeq(target)
+	b := s.String()
 	unholdSeq(s.Mutable, target)
+	x, err := parseNumber(b)
+	if err != nil {
+		return vm.Nil
+	}
+	return vm.NewNumber(x)
+}
+
+// parseNumber parses a string as a float or, failing that, as an integer with
+// a base prefix. Leading and trailing whitespace is ignored.
+func parseNumber(b string) (float64, error) {
+	b = strings.TrimSpace(b)
 	x, err := strconv.ParseFloat(b, 64)
 	if err != nil {
 		y, err := strconv.ParseInt(b, 0, 64)
 		if err != nil {
-			return vm.IoError(err)
+			return 0, err
 		}
 		x = float64(y)
 	}
-	return vm.NewNumber(x)
+	return x, nil
 }
 
 // SequenceAsOSPath is a Sequence method.
@@ -857,6 +946,102 @@ func SequenceCapitalize(vm *VM, target, locals *Object, msg *Message) *Object {
 	return target
 }
 
+// SequenceCapitalizeWords is a Sequence method.
+//
+// capitalizeWords replaces the first letter of each word in the sequence with
+// its capitalized equivalent, where words are separated by whitespace.
+// Combining marks at the start of a word are skipped, so the letter after them
+// is capitalized instead. This does not use special (Turkish) casing.
+func SequenceCapitalizeWords(vm *VM, target, locals *Object, msg *Message) *Object {
+	s := lockSeq(target)
+	defer target.Unlock()
+	if err := s.CheckMutable("capitalizeWords"); err != nil {
+		return vm.IoError(err)
+	}
+	target.Value = EncodeString(capitalizeWords(s.String()), s.Code, s.Kind())
+	return target
+}
+
+// capitalizeWords uppercases the first non-mark rune of each
+// whitespace-separated word in s.
+func capitalizeWords(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	start := true
+	for _, r := range s {
+		switch {
+		case unichr.IsSpace(r):
+			start = true
+		case start && !unichr.IsMark(r):
+			r = unichr.ToUpper(r)
+			start = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// SequenceCharAt is a Sequence method.
+//
+// charAt returns the item at the given index as a new one-item immutable
+// sequence with the same item type and encoding as the receiver, or nil if the
+// index is out of range. Unlike at, which gives the item's numeric value, the
+// result of charAt is text.
+//
+//   io> "abc" charAt(1)
+//   b
+func SequenceCharAt(vm *VM, target, locals *Object, msg *Message) *Object {
+	arg, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	i := int(arg)
+	s := holdSeq(target)
+	defer unholdSeq(s.Mutable, target)
+	if i < 0 || i >= s.Len() {
+		return vm.Nil
+	}
+	v := reflect.ValueOf(s.Value).Slice(i, i+1)
+	c := reflect.MakeSlice(v.Type(), 1, 1)
+	reflect.Copy(c, v)
+	return vm.NewSequence(c.Interface(), false, s.Code)
+}
+
+// SequenceChomp is a Sequence method.
+//
+// chomp returns a copy of the sequence with a single trailing line terminator,
+// either "\n" or "\r\n", removed. Unlike rstrip, other whitespace and further
+// line terminators are kept.
+func SequenceChomp(vm *VM, target, locals *Object, msg *Message) *Object {
+	s := holdSeq(target)
+	v := EncodeString(chomp(s.String()), s.Code, s.Kind())
+	v.Mutable = s.Mutable
+	unholdSeq(s.Mutable, target)
+	return vm.SequenceObject(v)
+}
+
+// SequenceChompInPlace is a Sequence method.
+//
+// chompInPlace removes a single trailing line terminator, either "\n" or
+// "\r\n", from the sequence.
+func SequenceChompInPlace(vm *VM, target, locals *Object, msg *Message) *Object {
+	s := lockSeq(target)
+	defer target.Unlock()
+	if err := s.CheckMutable("chompInPlace"); err != nil {
+		return vm.IoError(err)
+	}
+	target.Value = EncodeString(chomp(s.String()), s.Code, s.Kind())
+	return target
+}
+
+// chomp removes one trailing "\n" or "\r\n" from s.
+func chomp(s string) string {
+	if strings.HasSuffix(s, "\r\n") {
+		return s[:len(s)-2]
+	}
+	return strings.TrimSuffix(s, "\n")
+}
+
 // SequenceCloneAppendPath is a Sequence method.
 //
 // cloneAppendPath creates a new Symbol with the receiver's contents and the
@@ -894,6 +1079,90 @@ func SequenceCloneAppendPath(vm *VM, target, locals *Object, msg *Message) *Obje
 	return vm.SequenceObject(v)
 }
 
+// SequenceContainsFolded is a Sequence method.
+//
+// containsFolded determines whether the sequence contains the argument
+// sequence, ignoring case and diacritics. Both strings are Unicode case folded
+// and decomposed to NFD with combining marks removed before searching, so
+// that e.g. "Café" containsFolded("cafe") is true.
+func SequenceContainsFolded(vm *VM, target, locals *Object, msg *Message) *Object {
+	other, obj, stop := msg.SequenceArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(obj, stop)
+	}
+	if other.IsMutable() {
+		obj.Lock()
+	}
+	o := other.String()
+	if other.IsMutable() {
+		obj.Unlock()
+	}
+	s := holdSeq(target)
+	t := s.String()
+	unholdSeq(s.Mutable, target)
+	return vm.IoBool(strings.Contains(foldString(t), foldString(o)))
+}
+
+// foldString applies Unicode case folding to s, then decomposes it to NFD and
+// removes all combining marks.
+func foldString(s string) string {
+	s = norm.NFD.String(cases.Fold().String(s))
+	return strings.Map(func(r rune) rune {
+		if unichr.Is(unichr.Mn, r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// SequenceContainsSeqCaseInsensitive is a Sequence method.
+//
+// containsSeqCaseInsensitive determines whether the sequence contains the
+// argument sequence, comparing decoded characters converted to lowercase.
+func SequenceContainsSeqCaseInsensitive(vm *VM, target, locals *Object, msg *Message) *Object {
+	other, obj, stop := msg.SequenceArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(obj, stop)
+	}
+	if other.IsMutable() {
+		obj.Lock()
+	}
+	o := other.String()
+	if other.IsMutable() {
+		obj.Unlock()
+	}
+	s := holdSeq(target)
+	t := s.String()
+	unholdSeq(s.Mutable, target)
+	return vm.IoBool(findLower(lowerRunes(t), lowerRunes(o), 0) >= 0)
+}
+
+// lowerRunes decodes s and converts each rune to lowercase. Unlike
+// strings.ToLower, the result has exactly one rune for each rune of s, so
+// indices into it are indices into s.
+func lowerRunes(s string) []rune {
+	r := []rune(s)
+	for i, c := range r {
+		r[i] = unichr.ToLower(c)
+	}
+	return r
+}
+
+// findLower returns the index of the first occurrence of sub in s at or after
+// start, or -1 if there is none.
+func findLower(s, sub []rune, start int) int {
+	for i := start; i <= len(s)-len(sub); i++ {
+		j := 0
+		for j < len(sub) && s[i+j] == sub[j] {
+			j++
+		}
+		if j == len(sub) {
+			return i
+		}
+	}
+	return -1
+}
+
 // SequenceConvertToFixedSizeType is a Sequence method.
 //
 // convertToFixedSizeType converts the sequence to be  encoded in the first of
@@ -945,6 +1214,83 @@ func SequenceConvertToFixedSizeType(vm *VM, target, locals *Object, msg *Message
 	return target
 }
 
+// SequenceDedent is a Sequence method.
+//
+// dedent returns a new immutable sequence with the leading whitespace common
+// to all lines removed. Lines containing only whitespace are not considered
+// when finding the common indentation, and they become empty in the result.
+func SequenceDedent(vm *VM, target, locals *Object, msg *Message) *Object {
+	s := holdSeq(target)
+	sv := s.String()
+	code, kind := s.Code, s.Kind()
+	unholdSeq(s.Mutable, target)
+	v := EncodeString(dedent(sv), code, kind)
+	v.Mutable = false
+	return vm.SequenceObject(v)
+}
+
+// dedent removes the longest whitespace prefix shared by all non-blank lines
+// of s and empties blank lines.
+func dedent(s string) string {
+	lines := strings.Split(s, "\n")
+	prefix, found := "", false
+	for i, line := range lines {
+		t := strings.TrimLeft(line, " \t")
+		if t == "" {
+			lines[i] = ""
+			continue
+		}
+		indent := line[:len(line)-len(t)]
+		if !found {
+			prefix, found = indent, true
+			continue
+		}
+		k := 0
+		for k < len(prefix) && k < len(indent) && prefix[k] == indent[k] {
+			k++
+		}
+		prefix = prefix[:k]
+	}
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(line, prefix)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// SequenceDetectEncoding is a Sequence method.
+//
+// detectEncoding returns the name of the encoding indicated by a byte order
+// mark at the start of the sequence's bytes: "utf8", "utf16" or "utf32" for
+// UTF-8 and little-endian UTF-16 and UTF-32, matching the names accepted by
+// setEncoding, or "utf16be" or "utf32be" for big-endian UTF-16 and UTF-32. If
+// there is no byte order mark, the result is "utf8".
+func SequenceDetectEncoding(vm *VM, target, locals *Object, msg *Message) *Object {
+	s := holdSeq(target)
+	enc, _ := detectBOM(s.Bytes())
+	unholdSeq(s.Mutable, target)
+	return vm.NewString(enc)
+}
+
+// detectBOM returns the name of the encoding indicated by a byte order mark
+// at the start of b and the length of the mark in bytes. If there is no mark,
+// the result is "utf8" and 0.
+func detectBOM(b []byte) (enc string, n int) {
+	switch {
+	case bytes.HasPrefix(b, []byte{0xef, 0xbb, 0xbf}):
+		return "utf8", 3
+	case bytes.HasPrefix(b, []byte{0xff, 0xfe, 0, 0}):
+		// Must precede the UTF-16LE check, which shares a prefix.
+		return "utf32", 4
+	case bytes.HasPrefix(b, []byte{0, 0, 0xfe, 0xff}):
+		return "utf32be", 4
+	case bytes.HasPrefix(b, []byte{0xff, 0xfe}):
+		return "utf16", 2
+	case bytes.HasPrefix(b, []byte{0xfe, 0xff}):
+		return "utf16be", 2
+	}
+	return "utf8", 0
+}
+
 // SequenceEscape is a Sequence method.
 //
 // escape replaces control and non-printable characters with backslash-escaped
@@ -1020,6 +1366,303 @@ func SequenceEscape(vm *VM, target, locals *Object, msg *Message) *Object {
 	return target
 }
 
+// SequenceEscapeHTML is a Sequence method.
+//
+// escapeHtml returns a new immutable sequence with the characters <, >, &, ',
+// and " replaced with HTML entities.
+func SequenceEscapeHTML(vm *VM, target, locals *Object, msg *Message) *Object {
+	s := holdSeq(target)
+	sv := s.String()
+	code, kind := s.Code, s.Kind()
+	unholdSeq(s.Mutable, target)
+	v := EncodeString(html.EscapeString(sv), code, kind)
+	v.Mutable = false
+	return vm.SequenceObject(v)
+}
+
+// SequenceFindSeqCaseInsensitive is a Sequence method.
+//
+// findSeqCaseInsensitive locates the first occurrence of the argument sequence
+// in the receiver, optionally following a given start index, comparing decoded
+// characters converted to lowercase. Unlike findSeq, the start index and the
+// result count characters rather than items.
+//
+//   io> "Hello, World" findSeqCaseInsensitive("WORLD")
+//   7
+func SequenceFindSeqCaseInsensitive(vm *VM, target, locals *Object, msg *Message) *Object {
+	other, obj, stop := msg.SequenceArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(obj, stop)
+	}
+	if other.IsMutable() {
+		obj.Lock()
+	}
+	o := lowerRunes(other.String())
+	if other.IsMutable() {
+		obj.Unlock()
+	}
+	a := 0
+	if msg.ArgCount() > 1 {
+		n, exc, stop := msg.NumberArgAt(vm, locals, 1)
+		if stop != NoStop {
+			return vm.Stop(exc, stop)
+		}
+		a = int(n)
+	}
+	s := holdSeq(target)
+	t := lowerRunes(s.String())
+	unholdSeq(s.Mutable, target)
+	if a < 0 || a > len(t)-len(o) {
+		return vm.Nil
+	}
+	if k := findLower(t, o, a); k >= 0 {
+		return vm.NewNumber(float64(k))
+	}
+	return vm.Nil
+}
+
+// SequenceForeachLine is a Sequence method.
+//
+// foreachLine performs a loop for each line of the sequence, with the same
+// line splitting as splitLines, without building a list of all lines.
+//
+//   io> "a\r\nb\n" foreachLine(line, line size println)
+//   1
+//   1
+func SequenceForeachLine(vm *VM, target, locals *Object, msg *Message) (result *Object) {
+	_, vn, hkn, hvn, ev := ForeachArgs(msg)
+	if !hvn || hkn {
+		return vm.RaiseExceptionf("foreachLine requires 2 arguments")
+	}
+	k := 0
+	var control Stop
+	for {
+		s := holdSeq(target)
+		n := s.Len()
+		if k >= n {
+			unholdSeq(s.Mutable, target)
+			break
+		}
+		j := k
+		for j < n {
+			if v, _ := s.At(j); v == '\n' {
+				break
+			}
+			j++
+		}
+		line := Sequence{Value: reflect.ValueOf(s.Value).Slice(k, j).Interface(), Code: s.Code}.String()
+		unholdSeq(s.Mutable, target)
+		k = j + 1
+		vm.SetSlot(locals, vn, vm.NewString(strings.TrimSuffix(line, "\r")))
+		result, control = ev.Eval(vm, locals)
+		switch control {
+		case NoStop, ContinueStop: // do nothing
+		case BreakStop:
+			return result
+		case ReturnStop, ExceptionStop, ExitStop:
+			return vm.Stop(result, control)
+		default:
+			panic(fmt.Sprintf("iolang: invalid Stop: %v", control))
+		}
+	}
+	return result
+}
+
+// SequenceFormat is a Sequence method.
+//
+// format returns a new string formatting its arguments according to the
+// receiver, used as a printf-style format string. Each verb, along with any
+// flags, width, and precision, is formatted as by Go's fmt.Sprintf. The verbs
+// d, b, o, x, X, and c take Numbers, which are truncated to integers; e, E,
+// f, F, g, and G take Numbers; and s, q, and v take any object, converted
+// with asString. x and X also accept Sequences, giving the hex encoding of
+// their text. %% produces a literal percent sign. It is an error for the
+// number of verbs and arguments to differ.
+//
+//   io> "%s has %d items costing %.2f" format("cart", 3, 9.5)
+//   cart has 3 items costing 9.50
+func SequenceFormat(vm *VM, target, locals *Object, msg *Message) *Object {
+	s := holdSeq(target)
+	f := s.String()
+	unholdSeq(s.Mutable, target)
+	var b strings.Builder
+	n := 0
+	for {
+		i := strings.IndexByte(f, '%')
+		if i < 0 {
+			b.WriteString(f)
+			break
+		}
+		b.WriteString(f[:i])
+		f = f[i:]
+		// Find the verb, which is the first letter or % after the flags,
+		// width, and precision.
+		j := 1
+		for j < len(f) && strings.IndexByte("+-# 0123456789.", f[j]) >= 0 {
+			j++
+		}
+		if j >= len(f) {
+			return vm.RaiseExceptionf("format string ends in incomplete verb %q", f)
+		}
+		spec, verb := f[:j+1], f[j]
+		f = f[j+1:]
+		if verb == '%' {
+			if spec != "%%" {
+				return vm.RaiseExceptionf("invalid verb %q", spec)
+			}
+			b.WriteByte('%')
+			continue
+		}
+		if n >= msg.ArgCount() {
+			return vm.RaiseExceptionf("too few arguments to format: verb %d %s has no argument", n, spec)
+		}
+		arg, stop := msg.EvalArgAt(vm, locals, n)
+		if stop != NoStop {
+			return vm.Stop(arg, stop)
+		}
+		n++
+		var v interface{}
+		switch verb {
+		case 'd', 'b', 'o', 'x', 'X', 'c':
+			switch x := arg.Value.(type) {
+			case float64:
+				if verb == 'c' {
+					v = rune(x)
+				} else {
+					v = int64(x)
+				}
+			case Sequence:
+				if verb != 'x' && verb != 'X' {
+					return vm.RaiseExceptionf("argument %d to format for %s must be Number, not %s", n-1, spec, vm.TypeName(arg))
+				}
+				v = vm.AsString(arg)
+			default:
+				return vm.RaiseExceptionf("argument %d to format for %s must be Number, not %s", n-1, spec, vm.TypeName(arg))
+			}
+		case 'e', 'E', 'f', 'F', 'g', 'G':
+			x, ok := arg.Value.(float64)
+			if !ok {
+				return vm.RaiseExceptionf("argument %d to format for %s must be Number, not %s", n-1, spec, vm.TypeName(arg))
+			}
+			v = x
+		case 's', 'q', 'v':
+			v = vm.AsString(arg)
+		default:
+			return vm.RaiseExceptionf("unsupported verb %q", spec)
+		}
+		fmt.Fprintf(&b, spec, v)
+	}
+	if n < msg.ArgCount() {
+		return vm.RaiseExceptionf("too many arguments to format: %d verbs for %d arguments", n, msg.ArgCount())
+	}
+	return vm.NewString(b.String())
+}
+
+// SequenceFormatTable is a Sequence method.
+//
+// formatTable renders a Map of columns as an aligned text table, with the
+// sorted keys of the map as headers, a rule of dashes beneath them, and one
+// line per row. Each value must be a Sequence; number-encoded sequences give
+// one cell per element and are right-aligned, and text sequences give one
+// cell per line and are left-aligned. Shorter columns are padded with blank
+// cells. Columns are separated by two spaces.
+//
+//   io> Sequence formatTable(Map clone atPut("n", list(1, 10) asSequence) atPut("name", "a\nbc")) println
+//    n  name
+//   --  ----
+//    1  a
+//   10  bc
+func SequenceFormatTable(vm *VM, target, locals *Object, msg *Message) *Object {
+	m, stop := msg.EvalArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(m, stop)
+	}
+	if m.Tag() != MapTag {
+		return vm.RaiseExceptionf("argument 0 to formatTable must be Map, not %s", vm.TypeName(m))
+	}
+	m.Lock()
+	cols := make(map[string]*Object, len(m.Value.(map[string]*Object)))
+	for k, v := range m.Value.(map[string]*Object) {
+		cols[k] = v
+	}
+	m.Unlock()
+	keys := make([]string, 0, len(cols))
+	for k := range cols {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	cells := make([][]string, len(keys))
+	right := make([]bool, len(keys))
+	widths := make([]int, len(keys))
+	rows := 0
+	for i, k := range keys {
+		v := cols[k]
+		c, ok := v.Value.(Sequence)
+		if !ok {
+			return vm.RaiseExceptionf("column %q must be Sequence, not %s", k, vm.TypeName(v))
+		}
+		if c.IsMutable() {
+			v.Lock()
+		}
+		if c.Code == "number" {
+			right[i] = true
+			for j := 0; ; j++ {
+				x, ok := c.At(j)
+				if !ok {
+					break
+				}
+				cells[i] = append(cells[i], strconv.FormatFloat(x, 'g', -1, 64))
+			}
+		} else if sv := c.String(); sv != "" {
+			cells[i] = strings.Split(strings.TrimSuffix(sv, "\n"), "\n")
+		}
+		if c.IsMutable() {
+			v.Unlock()
+		}
+		widths[i] = utf8.RuneCountInString(k)
+		for _, x := range cells[i] {
+			if n := utf8.RuneCountInString(x); n > widths[i] {
+				widths[i] = n
+			}
+		}
+		if len(cells[i]) > rows {
+			rows = len(cells[i])
+		}
+	}
+	var b strings.Builder
+	line := func(cell func(i int) string) {
+		var l strings.Builder
+		for i := range keys {
+			if i > 0 {
+				l.WriteString("  ")
+			}
+			x := cell(i)
+			pad := strings.Repeat(" ", widths[i]-utf8.RuneCountInString(x))
+			if right[i] {
+				l.WriteString(pad)
+				l.WriteString(x)
+			} else {
+				l.WriteString(x)
+				l.WriteString(pad)
+			}
+		}
+		b.WriteString(strings.TrimRight(l.String(), " "))
+	}
+	line(func(i int) string { return keys[i] })
+	b.WriteByte('\n')
+	line(func(i int) string { return strings.Repeat("-", widths[i]) })
+	for j := 0; j < rows; j++ {
+		b.WriteByte('\n')
+		line(func(i int) string {
+			if j < len(cells[i]) {
+				return cells[i][j]
+			}
+			return ""
+		})
+	}
+	return vm.NewString(b.String())
+}
+
 // SequenceFromBase is a Sequence method.
 //
 // fromBase converts the sequence from a representation of an integer in a
@@ -1059,6 +1702,78 @@ func SequenceFromBase64(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.NewSequence(w[:n], false, "utf8")
 }
 
+// SequenceIndentBy is a Sequence method.
+//
+// indentBy returns a new immutable sequence with the argument prepended to
+// each line. Empty lines are left empty.
+func SequenceIndentBy(vm *VM, target, locals *Object, msg *Message) *Object {
+	prefix, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	s := holdSeq(target)
+	sv := s.String()
+	code, kind := s.Code, s.Kind()
+	unholdSeq(s.Mutable, target)
+	lines := strings.Split(sv, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = prefix + line
+		}
+	}
+	v := EncodeString(strings.Join(lines, "\n"), code, kind)
+	v.Mutable = false
+	return vm.SequenceObject(v)
+}
+
+// SequenceInsertEvery is a Sequence method.
+//
+// insertEvery returns a new immutable sequence with the argument inserted
+// after every n characters of the receiver, not including the end. Unlike
+// insertSeqEvery, this counts decoded characters rather than elements and does
+// not modify the receiver.
+//
+//   io> "1234567890" insertEvery(4, "-")
+//   1234-5678-90
+func SequenceInsertEvery(vm *VM, target, locals *Object, msg *Message) *Object {
+	n, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	sep, exc, stop := msg.StringArgAt(vm, locals, 1)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	if !(n >= 1) || math.IsInf(n, 1) {
+		return vm.RaiseExceptionf("insertEvery distance must be positive and finite, not %v", n)
+	}
+	s := holdSeq(target)
+	sv := s.String()
+	code, kind := s.Code, s.Kind()
+	unholdSeq(s.Mutable, target)
+	r := []rune(sv)
+	if n > float64(len(r)) {
+		// No separators to insert; clamp before converting so that huge
+		// distances don't overflow.
+		n = float64(len(r))
+	}
+	k := int(n)
+	var b strings.Builder
+	for i := 0; i < len(r); i += k {
+		if i > 0 {
+			b.WriteString(sep)
+		}
+		if i+k >= len(r) {
+			b.WriteString(string(r[i:]))
+		} else {
+			b.WriteString(string(r[i : i+k]))
+		}
+	}
+	v := EncodeString(b.String(), code, kind)
+	v.Mutable = false
+	return vm.SequenceObject(v)
+}
+
 // SequenceInterpolate is a Sequence method.
 //
 // interpolate replaces "#{Io code}" in the sequence with the result of
@@ -1204,6 +1919,160 @@ func SequenceLstrip(vm *VM, target, locals *Object, msg *Message) *Object {
 	return target
 }
 
+// SequenceNumberLines is a Sequence method.
+//
+// numberLines returns a new immutable sequence with each line prefixed by its
+// line number, right-aligned to the width of the largest number, and a space.
+// Numbering starts from the optional argument, or 1 by default. A trailing
+// newline does not begin a new numbered line.
+//
+//   io> "a\nb\nc" numberLines(9) println
+//    9 a
+//   10 b
+//   11 c
+func SequenceNumberLines(vm *VM, target, locals *Object, msg *Message) *Object {
+	start := 1
+	if msg.ArgCount() > 0 {
+		n, exc, stop := msg.NumberArgAt(vm, locals, 0)
+		if stop != NoStop {
+			return vm.Stop(exc, stop)
+		}
+		start = int(n)
+	}
+	s := holdSeq(target)
+	sv := s.String()
+	code, kind := s.Code, s.Kind()
+	unholdSeq(s.Mutable, target)
+	lines := strings.Split(sv, "\n")
+	n := len(lines)
+	if n > 1 && lines[n-1] == "" {
+		n--
+	}
+	w := len(strconv.Itoa(start))
+	if l := len(strconv.Itoa(start + n - 1)); l > w {
+		w = l
+	}
+	for i, line := range lines[:n] {
+		lines[i] = fmt.Sprintf("%*d %s", w, start+i, line)
+	}
+	v := EncodeString(strings.Join(lines, "\n"), code, kind)
+	v.Mutable = false
+	return vm.SequenceObject(v)
+}
+
+// SequencePadLeft is a Sequence method.
+//
+// padLeft returns a new immutable sequence with copies of the optional pad
+// sequence, which defaults to a single space, prepended to the receiver to
+// make it at least the given number of characters wide. The last copy of the
+// pad is truncated if needed. If the receiver is already wide enough, it is
+// returned unchanged.
+//
+//   io> "7" padLeft(3, "0")
+//   007
+func SequencePadLeft(vm *VM, target, locals *Object, msg *Message) *Object {
+	return padSeq(vm, target, locals, msg, true)
+}
+
+// SequencePadRight is a Sequence method.
+//
+// padRight returns a new immutable sequence with copies of the optional pad
+// sequence, which defaults to a single space, appended to the receiver to
+// make it at least the given number of characters wide. The last copy of the
+// pad is truncated if needed. If the receiver is already wide enough, it is
+// returned unchanged.
+//
+//   io> "ab" padRight(5, "-=")
+//   ab-=-
+func SequencePadRight(vm *VM, target, locals *Object, msg *Message) *Object {
+	return padSeq(vm, target, locals, msg, false)
+}
+
+// padSeq implements padLeft and padRight.
+func padSeq(vm *VM, target, locals *Object, msg *Message, left bool) *Object {
+	w, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	if math.IsNaN(w) || math.IsInf(w, 0) {
+		return vm.RaiseExceptionf("%s width must be finite, not %v", msg.Name(), w)
+	}
+	if w > math.MaxInt32 {
+		return vm.RaiseExceptionf("%s width %v is too large", msg.Name(), w)
+	}
+	pad := " "
+	if msg.ArgCount() > 1 {
+		pad, exc, stop = msg.StringArgAt(vm, locals, 1)
+		if stop != NoStop {
+			return vm.Stop(exc, stop)
+		}
+		if pad == "" {
+			return vm.RaiseExceptionf("%s pad must not be empty", msg.Name())
+		}
+	}
+	s := holdSeq(target)
+	sv := s.String()
+	code, kind := s.Code, s.Kind()
+	unholdSeq(s.Mutable, target)
+	need := int(w) - utf8.RuneCountInString(sv)
+	if need <= 0 {
+		return target
+	}
+	p := []rune(pad)
+	fill := make([]rune, need)
+	for i := range fill {
+		fill[i] = p[i%len(p)]
+	}
+	if left {
+		sv = string(fill) + sv
+	} else {
+		sv += string(fill)
+	}
+	v := EncodeString(sv, code, kind)
+	v.Mutable = false
+	return vm.SequenceObject(v)
+}
+
+// SequenceParseBoolean is a Sequence method.
+//
+// parseBoolean interprets the sequence as a boolean. Ignoring case and
+// surrounding whitespace, "true", "yes", "1", and "on" are true, and "false",
+// "no", "0", and "off" are false; anything else raises an exception. If the
+// optional argument is true, then the sequence must be exactly "true" or
+// "false".
+//
+// Sequences do not override asBoolean, so every sequence, including "false",
+// is still true in a condition.
+func SequenceParseBoolean(vm *VM, target, locals *Object, msg *Message) *Object {
+	strict := false
+	if msg.ArgCount() > 0 {
+		r, stop := msg.EvalArgAt(vm, locals, 0)
+		if stop != NoStop {
+			return vm.Stop(r, stop)
+		}
+		strict = vm.AsBool(r)
+	}
+	s := holdSeq(target)
+	b := s.String()
+	unholdSeq(s.Mutable, target)
+	if strict {
+		switch b {
+		case "true":
+			return vm.True
+		case "false":
+			return vm.False
+		}
+		return vm.RaiseExceptionf("%q is not true or false", b)
+	}
+	switch strings.ToLower(strings.TrimSpace(b)) {
+	case "true", "yes", "1", "on":
+		return vm.True
+	case "false", "no", "0", "off":
+		return vm.False
+	}
+	return vm.RaiseExceptionf("%q is not a boolean", b)
+}
+
 // SequenceParseJSON is a Sequence method.
 //
 // parseJson decodes the JSON represented by the receiver.
@@ -1397,6 +2266,118 @@ func SequencePercentEncoded(vm *VM, target, locals *Object, msg *Message) *Objec
 	return vm.NewString(url.PathEscape(r))
 }
 
+// SequenceRemoveBom is a Sequence method.
+//
+// removeBom removes a byte order mark, as recognized by detectEncoding, from
+// the start of the sequence, if present. The mark must occupy whole items of
+// the sequence.
+func SequenceRemoveBom(vm *VM, target, locals *Object, msg *Message) *Object {
+	s := lockSeq(target)
+	defer target.Unlock()
+	if err := s.CheckMutable("removeBom"); err != nil {
+		return vm.IoError(err)
+	}
+	_, n := detectBOM(s.Bytes())
+	if n == 0 {
+		return target
+	}
+	is := s.ItemSize()
+	if n%is != 0 {
+		return vm.RaiseExceptionf("byte order mark of %d bytes does not fit items of size %d", n, is)
+	}
+	target.Value = s.Remove(0, n/is)
+	return target
+}
+
+// SequenceRenderTemplate is a Sequence method.
+//
+// renderTemplate substitutes values from a Map into the sequence used as a
+// template. #{key} is replaced with the value at key converted to a string,
+// and text between #{if key} and the matching #{end} is included only if the
+// value at key is true. Conditionals may be nested. Unlike interpolate, no Io
+// code in the template is evaluated. If a key to be substituted is missing,
+// the optional second argument is used in its place; without it, it is an
+// error. A missing key in a conditional is false. Unbalanced conditionals are
+// errors.
+func SequenceRenderTemplate(vm *VM, target, locals *Object, msg *Message) *Object {
+	m, stop := msg.EvalArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(m, stop)
+	}
+	if m.Tag() != MapTag {
+		return vm.RaiseExceptionf("argument 0 to renderTemplate must be Map, not %s", vm.TypeName(m))
+	}
+	var dflt *Object
+	if msg.ArgCount() > 1 {
+		dflt, stop = msg.EvalArgAt(vm, locals, 1)
+		if stop != NoStop {
+			return vm.Stop(dflt, stop)
+		}
+	}
+	lookup := func(key string) (*Object, bool) {
+		m.Lock()
+		v, ok := m.Value.(map[string]*Object)[key]
+		m.Unlock()
+		return v, ok
+	}
+	s := holdSeq(target)
+	sv := s.String()
+	unholdSeq(s.Mutable, target)
+	var b strings.Builder
+	// ifs holds the positions of open conditionals and the inclusion state
+	// outside each of them.
+	var ifs []int
+	var outer []bool
+	include := true
+	k := 0
+	for {
+		i := strings.Index(sv[k:], "#{")
+		if i < 0 {
+			if include {
+				b.WriteString(sv[k:])
+			}
+			break
+		}
+		pos := k + i
+		if include {
+			b.WriteString(sv[k:pos])
+		}
+		j := strings.IndexByte(sv[pos+2:], '}')
+		if j < 0 {
+			return vm.RaiseExceptionf("unterminated #{ at position %d", utf8.RuneCountInString(sv[:pos]))
+		}
+		tag := strings.TrimSpace(sv[pos+2 : pos+2+j])
+		k = pos + j + 3
+		switch {
+		case strings.HasPrefix(tag, "if ") || strings.HasPrefix(tag, "if\t"):
+			v, ok := lookup(strings.TrimSpace(tag[3:]))
+			ifs = append(ifs, pos)
+			outer = append(outer, include)
+			include = include && ok && vm.AsBool(v)
+		case tag == "end":
+			if len(ifs) == 0 {
+				return vm.RaiseExceptionf("#{end} without #{if} at position %d", utf8.RuneCountInString(sv[:pos]))
+			}
+			include = outer[len(outer)-1]
+			ifs, outer = ifs[:len(ifs)-1], outer[:len(outer)-1]
+		case include:
+			v, ok := lookup(tag)
+			if !ok {
+				if dflt == nil {
+					return vm.RaiseExceptionf("no value for template key %q at position %d", tag, utf8.RuneCountInString(sv[:pos]))
+				}
+				v = dflt
+			}
+			b.WriteString(vm.AsString(v))
+		}
+	}
+	if len(ifs) > 0 {
+		pos := ifs[len(ifs)-1]
+		return vm.RaiseExceptionf("#{if} without #{end} at position %d", utf8.RuneCountInString(sv[:pos]))
+	}
+	return vm.NewString(b.String())
+}
+
 // SequenceRstrip is a Sequence method.
 //
 // rstrip removes all whitespace characters from the end of the sequence, or
@@ -1468,6 +2449,101 @@ func SequenceSplit(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.NewList(l...)
 }
 
+// SequenceSplitLines is a Sequence method.
+//
+// splitLines returns a list of the lines of the sequence, split at each "\n"
+// with any "\r" immediately before it removed. Empty lines are preserved,
+// but a final newline does not produce an empty last line.
+//
+//   io> "a\r\n\nb\n" splitLines
+//   list(a, , b)
+func SequenceSplitLines(vm *VM, target, locals *Object, msg *Message) *Object {
+	s := holdSeq(target)
+	sv := s.String()
+	unholdSeq(s.Mutable, target)
+	l := []*Object{}
+	for sv != "" {
+		line := sv
+		if k := strings.IndexByte(sv, '\n'); k >= 0 {
+			line, sv = sv[:k], sv[k+1:]
+		} else {
+			sv = ""
+		}
+		l = append(l, vm.NewString(strings.TrimSuffix(line, "\r")))
+	}
+	return vm.NewList(l...)
+}
+
+// SequenceSplitRespectingQuotes is a Sequence method.
+//
+// splitRespectingQuotes splits the sequence at each occurrence of the
+// separator, except that separators between single or double quotes do not
+// split. The quotes themselves are removed, and within quotes, a backslash
+// followed by the quote character or another backslash produces that
+// character. It is an error for a quote to be unterminated.
+//
+//   io> "a \"b c\" 'd'" splitRespectingQuotes(" ")
+//   list(a, b c, d)
+func SequenceSplitRespectingQuotes(vm *VM, target, locals *Object, msg *Message) *Object {
+	sep, exc, stop := msg.StringArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	if sep == "" {
+		return vm.RaiseExceptionf("separator for splitRespectingQuotes must not be empty")
+	}
+	s := holdSeq(target)
+	sv := s.String()
+	unholdSeq(s.Mutable, target)
+	fields, err := splitQuoted(sv, sep)
+	if err != nil {
+		return vm.IoError(err)
+	}
+	l := make([]*Object, len(fields))
+	for i, f := range fields {
+		l[i] = vm.NewString(f)
+	}
+	return vm.NewList(l...)
+}
+
+// splitQuoted splits s at each occurrence of sep outside of single or double
+// quotes, removing the quotes and unescaping backslashes within them.
+func splitQuoted(s, sep string) ([]string, error) {
+	var r []string
+	var b strings.Builder
+	var quote rune
+	start, pos := 0, 0
+	for i := 0; i < len(s); {
+		c, n := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote != 0 && c == '\\' && i+n < len(s) && (s[i+n] == byte(quote) || s[i+n] == '\\'):
+			b.WriteByte(s[i+n])
+			n++
+			pos++
+		case quote != 0:
+			b.WriteRune(c)
+		case c == '"' || c == '\'':
+			quote, start = c, pos
+		case strings.HasPrefix(s[i:], sep):
+			r = append(r, b.String())
+			b.Reset()
+			pos += utf8.RuneCountInString(sep)
+			i += len(sep)
+			continue
+		default:
+			b.WriteRune(c)
+		}
+		pos++
+		i += n
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote at position %d", quote, start)
+	}
+	return append(r, b.String()), nil
+}
+
 // SequenceStrip is a Sequence method.
 //
 // strip removes all whitespace characters from each end of the sequence, or
@@ -1492,6 +2568,53 @@ func SequenceStrip(vm *VM, target, locals *Object, msg *Message) *Object {
 	return target
 }
 
+// SequenceStripAnsi is a Sequence method.
+//
+// stripAnsi returns a copy of the sequence with ANSI terminal escape sequences,
+// such as color codes, removed. An incomplete escape sequence at the end of the
+// sequence is also removed.
+func SequenceStripAnsi(vm *VM, target, locals *Object, msg *Message) *Object {
+	s := holdSeq(target)
+	sv := s.String()
+	code, kind := s.Code, s.Kind()
+	unholdSeq(s.Mutable, target)
+	v := EncodeString(stripAnsi(sv), code, kind)
+	v.Mutable = false
+	return vm.SequenceObject(v)
+}
+
+// stripAnsi removes CSI sequences, i.e. ESC [ followed by parameter and
+// intermediate bytes and a final byte, and two-character escapes from s.
+func stripAnsi(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\x1b' {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		if i >= len(s) {
+			break
+		}
+		if s[i] != '[' {
+			// Two-character escape; drop the second character as well, unless
+			// it begins another escape.
+			if s[i] == '\x1b' {
+				i--
+			}
+			continue
+		}
+		// Skip parameter and intermediate bytes, then the final byte. If the
+		// sequence is malformed, keep whatever follows it.
+		for i++; i < len(s) && s[i] >= 0x20 && s[i] <= 0x3f; i++ {
+		}
+		if i < len(s) && (s[i] < 0x40 || s[i] > 0x7e) {
+			i--
+		}
+	}
+	return b.String()
+}
+
 // SequenceToBase is a Sequence method.
 //
 // toBase converts the sequence from a base 10 representation of a number to a
@@ -1531,6 +2654,21 @@ func SequenceUnescape(vm *VM, target, locals *Object, msg *Message) *Object {
 	return target
 }
 
+// SequenceUnescapeHTML is a Sequence method.
+//
+// unescapeHtml returns a new immutable sequence with HTML entities, including
+// numeric entities like &#39; and named entities like &eacute;, replaced with
+// the characters they represent.
+func SequenceUnescapeHTML(vm *VM, target, locals *Object, msg *Message) *Object {
+	s := holdSeq(target)
+	sv := s.String()
+	code, kind := s.Code, s.Kind()
+	unholdSeq(s.Mutable, target)
+	v := EncodeString(html.UnescapeString(sv), code, kind)
+	v.Mutable = false
+	return vm.SequenceObject(v)
+}
+
 // SequenceUppercase is a Sequence method.
 //
 // uppercase converts the values in the sequence to their capitalized
@@ -0,0 +1,305 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/zephyrtronium/iolang"
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+// TestSequenceStringMethods tests Sequence string methods.
+func TestSequenceStringMethods(t *testing.T) {
+	vm := testutils.VM()
+	cases := map[string]map[string]testutils.SourceTestCase{
+		"asDisplayString": {
+			"plain":    {Source: `"abc" asDisplayString`, Pass: testutils.PassEqual(vm.NewString("abc"))},
+			"control":  {Source: `"a\x01b\tc" asDisplayString`, Pass: testutils.PassEqual(vm.NewString(`a\x01b\tc`))},
+			"unicode":  {Source: `"h\u00e9llo \u2603" asDisplayString`, Pass: testutils.PassEqual(vm.NewString("h\u00e9llo \u2603"))},
+			"invalid":  {Source: `Sequence clone asMutable append(104, 255, 105) asDisplayString`, Pass: testutils.PassEqual(vm.NewString("h\ufffdi"))},
+			"raw":      {Source: `"a\tb" asDisplayString(false)`, Pass: testutils.PassEqual(vm.NewString("a\tb"))},
+			"rawBytes": {Source: `Sequence clone asMutable append(104, 255, 9) asDisplayString(false)`, Pass: testutils.PassEqual(vm.NewString("h\ufffd\t"))},
+		},
+		"asNumberOrNil": {
+			"integer":  {Source: `"42" asNumberOrNil`, Pass: testutils.PassEqual(vm.NewNumber(42))},
+			"float":    {Source: `"-1.5e2" asNumberOrNil`, Pass: testutils.PassEqual(vm.NewNumber(-150))},
+			"space":    {Source: `" 12\n" asNumberOrNil`, Pass: testutils.PassEqual(vm.NewNumber(12))},
+			"hex":      {Source: `"0x1f" asNumberOrNil`, Pass: testutils.PassEqual(vm.NewNumber(31))},
+			"binary":   {Source: `"0b101" asNumberOrNil`, Pass: testutils.PassEqual(vm.NewNumber(5))},
+			"utf16":    {Source: `"7" asUTF16 asNumberOrNil`, Pass: testutils.PassEqual(vm.NewNumber(7))},
+			"invalid":  {Source: `"12abc" asNumberOrNil`, Pass: testutils.PassIdentical(vm.Nil)},
+			"word":     {Source: `"abc" asNumberOrNil`, Pass: testutils.PassIdentical(vm.Nil)},
+			"empty":    {Source: `"" asNumberOrNil`, Pass: testutils.PassIdentical(vm.Nil)},
+			"asNumber": {Source: `"abc" asNumber`, Pass: testutils.PassFailure()},
+		},
+		"capitalizeWords": {
+			"words":     {Source: `"hello big world" asMutable capitalizeWords`, Pass: testutils.PassEqual(vm.NewString("Hello Big World"))},
+			"leading":   {Source: `"  hello" asMutable capitalizeWords`, Pass: testutils.PassEqual(vm.NewString("  Hello"))},
+			"runs":      {Source: `"a   b\t\nc" asMutable capitalizeWords`, Pass: testutils.PassEqual(vm.NewString("A   B\t\nC"))},
+			"marks":     {Source: "\"x \u0301ab\" asMutable capitalizeWords", Pass: testutils.PassEqual(vm.NewString("X \u0301Ab"))},
+			"unicode":   {Source: `"élan ßig" asMutable capitalizeWords`, Pass: testutils.PassEqual(vm.NewString("Élan ßig"))},
+			"encoding":  {Source: `"ab cd" asUTF16 asMutable capitalizeWords asUTF8`, Pass: testutils.PassEqual(vm.NewString("Ab Cd"))},
+			"immutable": {Source: `"ab cd" capitalizeWords`, Pass: testutils.PassFailure()},
+			"as":        {Source: `"ab cd" asCapitalizedWords`, Pass: testutils.PassEqual(vm.NewString("Ab Cd"))},
+			"asSymbol":  {Source: `"ab cd" asMutable asCapitalizedWords isMutable`, Pass: testutils.PassIdentical(vm.False)},
+		},
+		"charAt": {
+			"first":     {Source: `"abc" charAt(0)`, Pass: testutils.PassEqual(vm.NewString("a"))},
+			"last":      {Source: `"abc" charAt(2)`, Pass: testutils.PassEqual(vm.NewString("c"))},
+			"range":     {Source: `"abc" charAt(3)`, Pass: testutils.PassIdentical(vm.Nil)},
+			"negative":  {Source: `"abc" charAt(-1)`, Pass: testutils.PassIdentical(vm.Nil)},
+			"encoding":  {Source: `"abc" asUTF16 charAt(1) encoding`, Pass: testutils.PassEqual(vm.NewString("utf16"))},
+			"itemType":  {Source: `"abc" asUTF16 charAt(1) itemType`, Pass: testutils.PassEqual(vm.NewString("uint16"))},
+			"number":    {Source: `"abc" asMutable setEncoding("number") charAt(1) at(0)`, Pass: testutils.PassEqual(vm.NewNumber('b'))},
+			"immutable": {Source: `"abc" asMutable charAt(1) isMutable`, Pass: testutils.PassIdentical(vm.False)},
+			"copy":      {Source: `Object clone do(s := "abc" asMutable; c := s charAt(0); s atPut(0, 100)) c`, Pass: testutils.PassEqual(vm.NewString("a"))},
+		},
+		"chomp": {
+			"newline": {Source: `"a\n" chomp`, Pass: testutils.PassEqual(vm.NewString("a"))},
+			"crlf":    {Source: `"a\r\n" chomp`, Pass: testutils.PassEqual(vm.NewString("a"))},
+			"none":    {Source: `"a" chomp`, Pass: testutils.PassEqual(vm.NewString("a"))},
+			"double":  {Source: `"a\n\n" chomp`, Pass: testutils.PassEqual(vm.NewString("a\n"))},
+			"cr":      {Source: `"a\r" chomp`, Pass: testutils.PassEqual(vm.NewString("a\r"))},
+			"space":   {Source: `"a \n" chomp`, Pass: testutils.PassEqual(vm.NewString("a "))},
+			"copy":    {Source: `"a\n" asMutable do(chomp) size`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"mutable": {Source: `"a\n" asMutable chomp isMutable`, Pass: testutils.PassIdentical(vm.True)},
+		},
+		"chompInPlace": {
+			"newline":   {Source: `"a\n" asMutable chompInPlace`, Pass: testutils.PassEqual(vm.NewString("a"))},
+			"crlf":      {Source: `"a\r\n" asMutable chompInPlace`, Pass: testutils.PassEqual(vm.NewString("a"))},
+			"none":      {Source: `"a" asMutable chompInPlace`, Pass: testutils.PassEqual(vm.NewString("a"))},
+			"double":    {Source: `"a\n\n" asMutable chompInPlace`, Pass: testutils.PassEqual(vm.NewString("a\n"))},
+			"immutable": {Source: `"a\n" chompInPlace`, Pass: testutils.PassFailure()},
+		},
+		"containsFolded": {
+			"diacritic": {Source: `"café" containsFolded("cafe")`, Pass: testutils.PassIdentical(vm.True)},
+			"case":      {Source: `"Straße" containsFolded("STRASSE")`, Pass: testutils.PassIdentical(vm.True)},
+			"query":     {Source: `"cafe" containsFolded("CAFÉ")`, Pass: testutils.PassIdentical(vm.True)},
+			"missing":   {Source: `"café" containsFolded("tea")`, Pass: testutils.PassIdentical(vm.False)},
+			"bad":       {Source: `"café" containsFolded(1)`, Pass: testutils.PassFailure()},
+		},
+		"containsSeqCaseInsensitive": {
+			"case":      {Source: `"Hello, World" containsSeqCaseInsensitive("WORLD")`, Pass: testutils.PassIdentical(vm.True)},
+			"nonascii":  {Source: `"ÄPFEL" containsSeqCaseInsensitive("äpfel")`, Pass: testutils.PassIdentical(vm.True)},
+			"missing":   {Source: `"Hello" containsSeqCaseInsensitive("yellow")`, Pass: testutils.PassIdentical(vm.False)},
+			"unchanged": {Source: `Object clone do(s := "ABC" asMutable; s containsSeqCaseInsensitive("b")) s`, Pass: testutils.PassEqual(vm.NewString("ABC"))},
+			"bad":       {Source: `"abc" containsSeqCaseInsensitive(1)`, Pass: testutils.PassFailure()},
+		},
+		"dedent": {
+			"common":    {Source: `"  a\n    b\n  c" dedent`, Pass: testutils.PassEqual(vm.NewString("a\n  b\nc"))},
+			"none":      {Source: `"a\n  b" dedent`, Pass: testutils.PassEqual(vm.NewString("a\n  b"))},
+			"blank":     {Source: `"    a\n\n   \n    b" dedent`, Pass: testutils.PassEqual(vm.NewString("a\n\n\nb"))},
+			"tabs":      {Source: `"\ta\n\t\tb" dedent`, Pass: testutils.PassEqual(vm.NewString("a\n\tb"))},
+			"mixed":     {Source: `"\t a\n\t\tb" dedent`, Pass: testutils.PassEqual(vm.NewString(" a\n\tb"))},
+			"immutable": {Source: `"  a" asMutable dedent isMutable`, Pass: testutils.PassIdentical(vm.False)},
+		},
+		"detectEncoding": {
+			"utf8":    {Source: `"77u/YQ==" fromBase64 detectEncoding`, Pass: testutils.PassEqual(vm.NewString("utf8"))},
+			"utf16le": {Source: `"//5hAA==" fromBase64 detectEncoding`, Pass: testutils.PassEqual(vm.NewString("utf16"))},
+			"utf16be": {Source: `"/v8AYQ==" fromBase64 detectEncoding`, Pass: testutils.PassEqual(vm.NewString("utf16be"))},
+			"utf32le": {Source: `"//4AAGEAAAA=" fromBase64 detectEncoding`, Pass: testutils.PassEqual(vm.NewString("utf32"))},
+			"utf32be": {Source: `"AAD+/wAAAGE=" fromBase64 detectEncoding`, Pass: testutils.PassEqual(vm.NewString("utf32be"))},
+			"none":    {Source: `"abc" detectEncoding`, Pass: testutils.PassEqual(vm.NewString("utf8"))},
+			"partial": {Source: `"77s=" fromBase64 detectEncoding`, Pass: testutils.PassEqual(vm.NewString("utf8"))},
+			"empty":   {Source: `"" detectEncoding`, Pass: testutils.PassEqual(vm.NewString("utf8"))},
+		},
+		"escapeHtml": {
+			"special":   {Source: `"<a href=\"x\">&'</a>" escapeHtml`, Pass: testutils.PassEqual(vm.NewString("&lt;a href=&#34;x&#34;&gt;&amp;&#39;&lt;/a&gt;"))},
+			"plain":     {Source: `"abc" escapeHtml`, Pass: testutils.PassEqual(vm.NewString("abc"))},
+			"immutable": {Source: `"<" asMutable escapeHtml isMutable`, Pass: testutils.PassIdentical(vm.False)},
+		},
+		"findSeqCaseInsensitive": {
+			"case":     {Source: `"Hello, World" findSeqCaseInsensitive("WORLD")`, Pass: testutils.PassEqual(vm.NewNumber(7))},
+			"runes":    {Source: `"ÄÄb" findSeqCaseInsensitive("B")`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"start":    {Source: `"abAB" findSeqCaseInsensitive("ab", 1)`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"missing":  {Source: `"abc" findSeqCaseInsensitive("d")`, Pass: testutils.PassIdentical(vm.Nil)},
+			"past":     {Source: `"abc" findSeqCaseInsensitive("c", 3)`, Pass: testutils.PassIdentical(vm.Nil)},
+			"negative": {Source: `"abc" findSeqCaseInsensitive("a", -1)`, Pass: testutils.PassIdentical(vm.Nil)},
+			"bad":      {Source: `"abc" findSeqCaseInsensitive(1)`, Pass: testutils.PassFailure()},
+		},
+		"format": {
+			"mixed":     {Source: `"%s has %d items costing %.2f" format("cart", 3, 9.5)`, Pass: testutils.PassEqual(vm.NewString("cart has 3 items costing 9.50"))},
+			"truncate":  {Source: `"%d" format(-2.7)`, Pass: testutils.PassEqual(vm.NewString("-2"))},
+			"width":     {Source: `"[%5d|%-4s|%05.1f]" format(42, "ab", 3.14159)`, Pass: testutils.PassEqual(vm.NewString("[   42|ab  |003.1]"))},
+			"hex":       {Source: `"%x %X %x" format(255, 255, "hi")`, Pass: testutils.PassEqual(vm.NewString("ff FF 6869"))},
+			"char":      {Source: `"%c%c" format(72, 105)`, Pass: testutils.PassEqual(vm.NewString("Hi"))},
+			"percent":   {Source: `"100%% of %s" format("it")`, Pass: testutils.PassEqual(vm.NewString("100% of it"))},
+			"asString":  {Source: `"%s %v" format(nil, list(1))`, Pass: testutils.PassEqual(vm.NewString("nil list(1)"))},
+			"quote":     {Source: `"%q" format("a\"b")`, Pass: testutils.PassEqual(vm.NewString(`"a\"b"`))},
+			"none":      {Source: `"plain" format`, Pass: testutils.PassEqual(vm.NewString("plain"))},
+			"tooFew":    {Source: `"%d %d" format(1)`, Pass: testutils.PassFailure()},
+			"tooMany":   {Source: `"%d" format(1, 2)`, Pass: testutils.PassFailure()},
+			"type":      {Source: `"%d" format("x")`, Pass: testutils.PassFailure()},
+			"float":     {Source: `"%f" format("x")`, Pass: testutils.PassFailure()},
+			"unknown":   {Source: `"%z" format(1)`, Pass: testutils.PassFailure()},
+			"trailing":  {Source: `"50%" format`, Pass: testutils.PassFailure()},
+			"continue":  {Source: `"%d" format(continue)`, Pass: testutils.PassControl(vm.Nil, iolang.ContinueStop)},
+			"immutable": {Source: `"%d" asMutable format(1) isMutable`, Pass: testutils.PassIdentical(vm.False)},
+		},
+		"formatTable": {
+			"mixed":     {Source: `Sequence formatTable(Map clone atPut("n", list(1, 10) asSequence) atPut("name", "a\nbc"))`, Pass: testutils.PassEqual(vm.NewString(" n  name\n--  ----\n 1  a\n10  bc"))},
+			"unequal":   {Source: `Sequence formatTable(Map clone atPut("a", "x") atPut("b", list(1, 2, 3) asSequence))`, Pass: testutils.PassEqual(vm.NewString("a  b\n-  -\nx  1\n   2\n   3"))},
+			"wideHead":  {Source: `Sequence formatTable(Map clone atPut("value", list(5) asSequence))`, Pass: testutils.PassEqual(vm.NewString("value\n-----\n    5"))},
+			"unicode":   {Source: `Sequence formatTable(Map clone atPut("k", "héllo") atPut("z", "1"))`, Pass: testutils.PassEqual(vm.NewString("k      z\n-----  -\nhéllo  1"))},
+			"empty":     {Source: `Sequence formatTable(Map clone)`, Pass: testutils.PassEqual(vm.NewString("\n"))},
+			"immutable": {Source: `Sequence formatTable(Map clone atPut("a", "b")) isMutable`, Pass: testutils.PassIdentical(vm.False)},
+			"notMap":    {Source: `Sequence formatTable(1)`, Pass: testutils.PassFailure()},
+			"notSeq":    {Source: `Sequence formatTable(Map clone atPut("a", 1))`, Pass: testutils.PassFailure()},
+		},
+		"insertEvery": {
+			"groups":    {Source: `"1234567890" insertEvery(4, "-")`, Pass: testutils.PassEqual(vm.NewString("1234-5678-90"))},
+			"exact":     {Source: `"12345678" insertEvery(4, " ")`, Pass: testutils.PassEqual(vm.NewString("1234 5678"))},
+			"short":     {Source: `"12" insertEvery(4, "-")`, Pass: testutils.PassEqual(vm.NewString("12"))},
+			"empty":     {Source: `"" insertEvery(2, "-")`, Pass: testutils.PassEqual(vm.NewString(""))},
+			"runes":     {Source: `"\u00e9\u00e9\u00e9" insertEvery(1, ":")`, Pass: testutils.PassEqual(vm.NewString("\u00e9:\u00e9:\u00e9"))},
+			"immutable": {Source: `"abcd" asMutable insertEvery(2, "-") isMutable`, Pass: testutils.PassIdentical(vm.False)},
+			"receiver":  {Source: `Object clone do(s := "abcd" asMutable; s insertEvery(2, "-"); r := s) r`, Pass: testutils.PassEqual(vm.NewString("abcd"))},
+			"zero":      {Source: `"abcd" insertEvery(0, "-")`, Pass: testutils.PassFailure()},
+			"huge":      {Source: `"abcd" insertEvery(2 ** 64, "-")`, Pass: testutils.PassEqual(vm.NewString("abcd"))},
+			"emptyHuge": {Source: `"" insertEvery(2 ** 64, "-")`, Pass: testutils.PassEqual(vm.NewString(""))},
+			"inf":       {Source: `"abcd" insertEvery(1 / 0, "-")`, Pass: testutils.PassFailure()},
+			"nan":       {Source: `"abcd" insertEvery(Number constants nan, "-")`, Pass: testutils.PassFailure()},
+		},
+		"indentBy": {
+			"lines":     {Source: `"a\nb" indentBy("  ")`, Pass: testutils.PassEqual(vm.NewString("  a\n  b"))},
+			"empty":     {Source: `"a\n\nb\n" indentBy("> ")`, Pass: testutils.PassEqual(vm.NewString("> a\n\n> b\n"))},
+			"immutable": {Source: `"a" asMutable indentBy("  ") isMutable`, Pass: testutils.PassIdentical(vm.False)},
+			"bad":       {Source: `"a" indentBy(1)`, Pass: testutils.PassFailure()},
+		},
+		"padLeft": {
+			"default":   {Source: `"ab" padLeft(4)`, Pass: testutils.PassEqual(vm.NewString("  ab"))},
+			"pad":       {Source: `"7" padLeft(3, "0")`, Pass: testutils.PassEqual(vm.NewString("007"))},
+			"truncate":  {Source: `"x" padLeft(4, "ab")`, Pass: testutils.PassEqual(vm.NewString("abax"))},
+			"runes":     {Source: `"\u00e9" padLeft(3, "\u00b7")`, Pass: testutils.PassEqual(vm.NewString("\u00b7\u00b7\u00e9"))},
+			"wide":      {Source: `Object clone do(s := "abc" asMutable; r := s padLeft(2) isIdenticalTo(s)) r`, Pass: testutils.PassIdentical(vm.True)},
+			"immutable": {Source: `"a" asMutable padLeft(2) isMutable`, Pass: testutils.PassIdentical(vm.False)},
+			"empty":     {Source: `"a" padLeft(2, "")`, Pass: testutils.PassFailure()},
+			"negative":  {Source: `"a" padLeft(-2)`, Pass: testutils.PassEqual(vm.NewString("a"))},
+			"huge":      {Source: `"a" padLeft(2 ** 64)`, Pass: testutils.PassFailure()},
+			"inf":       {Source: `"a" padLeft(1 / 0)`, Pass: testutils.PassFailure()},
+			"nan":       {Source: `"a" padLeft(Number constants nan)`, Pass: testutils.PassFailure()},
+		},
+		"padRight": {
+			"default":  {Source: `"ab" padRight(4)`, Pass: testutils.PassEqual(vm.NewString("ab  "))},
+			"truncate": {Source: `"ab" padRight(5, "-=")`, Pass: testutils.PassEqual(vm.NewString("ab-=-"))},
+			"runes":    {Source: `"\u00e9\u00e9" padRight(3)`, Pass: testutils.PassEqual(vm.NewString("\u00e9\u00e9 "))},
+			"wide":     {Source: `"abc" padRight(3)`, Pass: testutils.PassEqual(vm.NewString("abc"))},
+			"utf16":    {Source: `"a" asUTF16 padRight(2) encoding`, Pass: testutils.PassEqual(vm.NewString("utf16"))},
+			"huge":     {Source: `"a" padRight(2 ** 64)`, Pass: testutils.PassFailure()},
+			"negInf":   {Source: `"a" padRight(-1 / 0)`, Pass: testutils.PassFailure()},
+		},
+		"parseBoolean": {
+			"true":        {Source: `"true" parseBoolean`, Pass: testutils.PassIdentical(vm.True)},
+			"yes":         {Source: `" YES\n" parseBoolean`, Pass: testutils.PassIdentical(vm.True)},
+			"one":         {Source: `"1" parseBoolean`, Pass: testutils.PassIdentical(vm.True)},
+			"on":          {Source: `"On" parseBoolean`, Pass: testutils.PassIdentical(vm.True)},
+			"false":       {Source: `"FALSE" parseBoolean`, Pass: testutils.PassIdentical(vm.False)},
+			"no":          {Source: `"no" parseBoolean`, Pass: testutils.PassIdentical(vm.False)},
+			"zero":        {Source: `"0" parseBoolean`, Pass: testutils.PassIdentical(vm.False)},
+			"off":         {Source: `" off" parseBoolean`, Pass: testutils.PassIdentical(vm.False)},
+			"other":       {Source: `"maybe" parseBoolean`, Pass: testutils.PassFailure()},
+			"empty":       {Source: `"" parseBoolean`, Pass: testutils.PassFailure()},
+			"strict":      {Source: `"false" parseBoolean(true)`, Pass: testutils.PassIdentical(vm.False)},
+			"strictCase":  {Source: `"True" parseBoolean(true)`, Pass: testutils.PassFailure()},
+			"strictOther": {Source: `"yes" parseBoolean(true)`, Pass: testutils.PassFailure()},
+			"asBoolean":   {Source: `"false" asBoolean`, Pass: testutils.PassIdentical(vm.True)},
+			"ifZero":      {Source: `if("0", 1, 2)`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"ifFalse":     {Source: `if("false", 1, 2)`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"ifEmpty":     {Source: `if("", 1, 2)`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+		},
+		"numberLines": {
+			"three":     {Source: `"a\nb\nc" numberLines`, Pass: testutils.PassEqual(vm.NewString("1 a\n2 b\n3 c"))},
+			"align":     {Source: `"a\nb\nc" numberLines(9)`, Pass: testutils.PassEqual(vm.NewString(" 9 a\n10 b\n11 c"))},
+			"trailing":  {Source: `"a\nb\n" numberLines`, Pass: testutils.PassEqual(vm.NewString("1 a\n2 b\n"))},
+			"empty":     {Source: `"" numberLines`, Pass: testutils.PassEqual(vm.NewString("1 "))},
+			"negative":  {Source: `"a\nb" numberLines(-1)`, Pass: testutils.PassEqual(vm.NewString("-1 a\n 0 b"))},
+			"immutable": {Source: `"a" asMutable numberLines isMutable`, Pass: testutils.PassIdentical(vm.False)},
+			"bad":       {Source: `"a" numberLines("x")`, Pass: testutils.PassFailure()},
+		},
+		"removeBom": {
+			"utf8":      {Source: `"77u/YQ==" fromBase64 asMutable removeBom`, Pass: testutils.PassEqual(vm.NewString("a"))},
+			"utf16le":   {Source: `"//5hAA==" fromBase64 asMutable removeBom setEncoding("utf16") asUTF8`, Pass: testutils.PassEqual(vm.NewString("a"))},
+			"utf32le":   {Source: `"//4AAGEAAAA=" fromBase64 asMutable removeBom setEncoding("utf32") asUTF8`, Pass: testutils.PassEqual(vm.NewString("a"))},
+			"utf16be":   {Source: `"/v8AYQ==" fromBase64 asMutable removeBom size`, Pass: testutils.PassEqual(vm.NewNumber(2))},
+			"none":      {Source: `"abc" asMutable removeBom`, Pass: testutils.PassEqual(vm.NewString("abc"))},
+			"items":     {Source: `"//5hAA==" fromBase64 asMutable setItemType("uint16") removeBom size`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"misfit":    {Source: `"77u/YQ==" fromBase64 asMutable setItemType("uint16") removeBom`, Pass: testutils.PassFailure()},
+			"immutable": {Source: `"77u/YQ==" fromBase64 removeBom`, Pass: testutils.PassFailure()},
+		},
+		"renderTemplate": {
+			"substitute": {Source: `"Hello, #{name}!" renderTemplate(Map clone atPut("name", "Io"))`, Pass: testutils.PassEqual(vm.NewString("Hello, Io!"))},
+			"number":     {Source: `"#{ n } items" renderTemplate(Map clone atPut("n", 3))`, Pass: testutils.PassEqual(vm.NewString("3 items"))},
+			"ifTrue":     {Source: `"a#{if x}b#{end}c" renderTemplate(Map clone atPut("x", true))`, Pass: testutils.PassEqual(vm.NewString("abc"))},
+			"ifFalse":    {Source: `"a#{if x}b#{end}c" renderTemplate(Map clone atPut("x", false))`, Pass: testutils.PassEqual(vm.NewString("ac"))},
+			"ifMissing":  {Source: `"a#{if x}b#{end}c" renderTemplate(Map clone)`, Pass: testutils.PassEqual(vm.NewString("ac"))},
+			"nested":     {Source: `"#{if x}1#{if y}2#{end}3#{end}" renderTemplate(Map clone atPut("x", true) atPut("y", nil))`, Pass: testutils.PassEqual(vm.NewString("13"))},
+			"skipped":    {Source: `"#{if x}#{missing}#{end}ok" renderTemplate(Map clone)`, Pass: testutils.PassEqual(vm.NewString("ok"))},
+			"missing":    {Source: `"#{missing}" renderTemplate(Map clone)`, Pass: testutils.PassFailure()},
+			"default":    {Source: `"[#{missing}]" renderTemplate(Map clone, "?")`, Pass: testutils.PassEqual(vm.NewString("[?]"))},
+			"unclosed":   {Source: `"#{if x}a" renderTemplate(Map clone)`, Pass: testutils.PassFailure()},
+			"extraEnd":   {Source: `"a#{end}" renderTemplate(Map clone)`, Pass: testutils.PassFailure()},
+			"unterm":     {Source: `"a#{b" renderTemplate(Map clone)`, Pass: testutils.PassFailure()},
+			"noEval":     {Source: `"#{1 + 1}" renderTemplate(Map clone, "x")`, Pass: testutils.PassEqual(vm.NewString("x"))},
+			"notMap":     {Source: `"a" renderTemplate(1)`, Pass: testutils.PassFailure()},
+		},
+		"foreachLine": {
+			"lines":     {Source: `Object clone do(r := list; "a\r\n\nb\n" foreachLine(line, r append(line))) r`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a"), vm.NewString(""), vm.NewString("b")))},
+			"result":    {Source: `Object clone do(r := "a\nb" foreachLine(line, line .. "!")) r`, Pass: testutils.PassEqual(vm.NewString("b!"))},
+			"empty":     {Source: `"" foreachLine(line, Exception raise)`, Pass: testutils.PassIdentical(vm.Nil)},
+			"utf16":     {Source: `Object clone do(r := list; "\u00e9\nx" asUTF16 foreachLine(line, r append(line))) r`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("\u00e9"), vm.NewString("x")))},
+			"mutable":   {Source: `Object clone do(s := "a\nb" asMutable; r := list; s foreachLine(line, r append(line); if(line == "a", s appendSeq("\nc")))) r`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a"), vm.NewString("b"), vm.NewString("c")))},
+			"continue":  {Source: `Object clone do(r := list; "a\nb" foreachLine(line, r append(line); continue; r append(line))) r`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a"), vm.NewString("b")))},
+			"break":     {Source: `Object clone do(n := 0; "a\nb\nc" foreachLine(line, n = n + 1; break)) n`, Pass: testutils.PassEqual(vm.NewNumber(1))},
+			"breakRes":  {Source: `Object clone do(r := "a\nb" foreachLine(line, break(Lobby))) r`, Pass: testutils.PassIdentical(vm.Lobby)},
+			"return":    {Source: `Object clone do("a\nb" foreachLine(line, return))`, Pass: testutils.PassControl(vm.Nil, iolang.ReturnStop)},
+			"exception": {Source: `Object clone do("a" foreachLine(line, Exception raise))`, Pass: testutils.PassFailure()},
+			"short":     {Source: `"a" foreachLine(nil)`, Pass: testutils.PassFailure()},
+			"long":      {Source: `"a" foreachLine(i, line, nil)`, Pass: testutils.PassFailure()},
+		},
+		"splitLines": {
+			"lf":        {Source: `"a\nb" splitLines`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a"), vm.NewString("b")))},
+			"crlf":      {Source: `"a\r\nb\r\n" splitLines`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a"), vm.NewString("b")))},
+			"blank":     {Source: `"a\n\n\nb" splitLines`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a"), vm.NewString(""), vm.NewString(""), vm.NewString("b")))},
+			"trailing":  {Source: `"a\n" splitLines`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a")))},
+			"onlyBlank": {Source: `"\n\n" splitLines`, Pass: testutils.PassEqual(vm.NewList(vm.NewString(""), vm.NewString("")))},
+			"bareCR":    {Source: `"a\rb" splitLines`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a\rb")))},
+			"spaces":    {Source: `" a b \n" splitLines`, Pass: testutils.PassEqual(vm.NewList(vm.NewString(" a b ")))},
+			"empty":     {Source: `"" splitLines`, Pass: testutils.PassEqual(vm.NewList())},
+			"immutable": {Source: `"a" asMutable splitLines first isMutable`, Pass: testutils.PassIdentical(vm.False)},
+		},
+		"splitRespectingQuotes": {
+			"double":     {Source: `"a \"b,c\" d" splitRespectingQuotes(" ")`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a"), vm.NewString("b,c"), vm.NewString("d")))},
+			"comma":      {Source: `"a \"b,c\" d" splitRespectingQuotes(",")`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a b,c d")))},
+			"commas":     {Source: `"a,\"b,c\",d" splitRespectingQuotes(",")`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a"), vm.NewString("b,c"), vm.NewString("d")))},
+			"single":     {Source: `"x 'y \"z' w" splitRespectingQuotes(" ")`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("x"), vm.NewString("y \"z"), vm.NewString("w")))},
+			"escape":     {Source: `"\"a\\\"b\"" splitRespectingQuotes(" ")`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a\"b")))},
+			"adjacent":   {Source: `"pre\"a b\"post" splitRespectingQuotes(" ")`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("prea bpost")))},
+			"empty":      {Source: `"a,,\"\"" splitRespectingQuotes(",")`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a"), vm.NewString(""), vm.NewString("")))},
+			"multi":      {Source: `"a::'b::c'::d" splitRespectingQuotes("::")`, Pass: testutils.PassEqual(vm.NewList(vm.NewString("a"), vm.NewString("b::c"), vm.NewString("d")))},
+			"unbalanced": {Source: `"a \"b" splitRespectingQuotes(" ")`, Pass: testutils.PassFailure()},
+			"position":   {Source: `try("ab 'c" splitRespectingQuotes(" ")) error`, Pass: testutils.PassEqual(vm.NewString("unterminated ' quote at position 3"))},
+			"emptySep":   {Source: `"a" splitRespectingQuotes("")`, Pass: testutils.PassFailure()},
+		},
+		"stripAnsi": {
+			"plain":      {Source: `"abc" stripAnsi`, Pass: testutils.PassEqual(vm.NewString("abc"))},
+			"color":      {Source: `"\x1b[31mred\x1b[0m text" stripAnsi`, Pass: testutils.PassEqual(vm.NewString("red text"))},
+			"params":     {Source: `"\x1b[1;38;5;208mx\x1b[K" stripAnsi`, Pass: testutils.PassEqual(vm.NewString("x"))},
+			"twoChar":    {Source: `"a\x1b7b\x1b8c" stripAnsi`, Pass: testutils.PassEqual(vm.NewString("abc"))},
+			"incomplete": {Source: `"abc\x1b[1;3" stripAnsi`, Pass: testutils.PassEqual(vm.NewString("abc"))},
+			"lone":       {Source: `"abc\x1b" stripAnsi`, Pass: testutils.PassEqual(vm.NewString("abc"))},
+			"unicode":    {Source: `"\x1b[32mcafé\x1b[m" stripAnsi`, Pass: testutils.PassEqual(vm.NewString("café"))},
+			"immutable":  {Source: `"\x1b[31mx" asMutable stripAnsi isMutable`, Pass: testutils.PassIdentical(vm.False)},
+		},
+		"unescapeHtml": {
+			"named":     {Source: `"&lt;&amp;&eacute;" unescapeHtml`, Pass: testutils.PassEqual(vm.NewString("<&é"))},
+			"numeric":   {Source: `"&#39;&#x41;" unescapeHtml`, Pass: testutils.PassEqual(vm.NewString("'A"))},
+			"roundTrip": {Source: `"<'\"&>" escapeHtml unescapeHtml`, Pass: testutils.PassEqual(vm.NewString("<'\"&>"))},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			for name, s := range c {
+				t.Run(name, s.TestFunc("TestSequenceStringMethods"))
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"sync"
 )
 
@@ -12,15 +13,20 @@ func (vm *VM) initSystem() {
 	slots := Slots{
 		"activeCpus":             vm.NewCFunction(SystemActiveCpus, nil),
 		"arch":                   vm.NewString(runtime.GOARCH),
+		"environmentVariables":   vm.NewCFunction(SystemEnvironmentVariables, nil),
 		"exit":                   vm.NewCFunction(SystemExit, nil),
+		"flushOutput":            vm.NewCFunction(SystemFlushOutput, nil),
 		"getEnvironmentVariable": vm.NewCFunction(SystemGetEnvironmentVariable, nil),
 		"iovmName":               vm.NewString("github.com/zephyrtronium/iolang"),
 		"iospecVersion":          vm.NewString(IoSpecVer),
+		"isOutputBuffered":       vm.NewCFunction(SystemIsOutputBuffered, nil),
 		"launchScript":           vm.Nil,
 		"platform":               vm.NewString(runtime.GOOS),
 		"platformVersion":        vm.NewString(platformVersion),
 		"setEnvironmentVariable": vm.NewCFunction(SystemSetEnvironmentVariable, nil),
 		"setLobby":               vm.NewCFunction(SystemSetLobby, nil),
+		"setOutputBuffered":      vm.NewCFunction(SystemSetOutputBuffered, nil),
+		"setRandomSeed":          vm.NewCFunction(SystemSetRandomSeed, nil),
 		// TODO: sleep
 		// TODO: system
 		"thisProcessPid": vm.NewCFunction(SystemThisProcessPid, nil),
@@ -102,6 +108,28 @@ func SystemActiveCpus(vm *VM, target, locals *Object, msg *Message) *Object {
 	return vm.NewNumber(float64(runtime.GOMAXPROCS(0)))
 }
 
+// SystemEnvironmentVariables is a System method.
+//
+// environmentVariables returns a Map of the names of all environment variables
+// to their values.
+func SystemEnvironmentVariables(vm *VM, target, locals *Object, msg *Message) *Object {
+	env := os.Environ()
+	m := make(map[string]*Object, len(env))
+	for _, kv := range env {
+		// On Windows, some special variables have names beginning with =, so
+		// the separator is the first = after the first byte.
+		k := strings.IndexByte(kv, '=')
+		if k == 0 {
+			k = strings.IndexByte(kv[1:], '=') + 1
+		}
+		if k <= 0 {
+			continue
+		}
+		m[kv[:k]] = vm.NewString(kv[k+1:])
+	}
+	return vm.NewMap(m)
+}
+
 // SystemExit is a System method.
 //
 // exit exits the process with an exit code which defaults to 0.
@@ -120,6 +148,16 @@ func SystemExit(vm *VM, target, locals *Object, msg *Message) *Object {
 	return nil
 }
 
+// SystemFlushOutput is a System method.
+//
+// flushOutput writes any buffered standard output.
+func SystemFlushOutput(vm *VM, target, locals *Object, msg *Message) *Object {
+	if err := vm.Stdout.Flush(); err != nil {
+		return vm.IoError(err)
+	}
+	return target
+}
+
 // SystemGetEnvironmentVariable is a System method.
 //
 // getEnvironmentVariable returns the value of the environment variable with
@@ -136,9 +174,17 @@ func SystemGetEnvironmentVariable(vm *VM, target, locals *Object, msg *Message)
 	return vm.Nil
 }
 
+// SystemIsOutputBuffered is a System method.
+//
+// isOutputBuffered returns whether standard output is buffered.
+func SystemIsOutputBuffered(vm *VM, target, locals *Object, msg *Message) *Object {
+	return vm.IoBool(vm.Stdout.IsBuffered())
+}
+
 // SystemSetEnvironmentVariable is a System method.
 //
-// setEnvironmentVariable sets the value of an environment variable.
+// setEnvironmentVariable sets the value of an environment variable and returns
+// the value.
 func SystemSetEnvironmentVariable(vm *VM, target, locals *Object, msg *Message) *Object {
 	name, exc, stop := msg.StringArgAt(vm, locals, 0)
 	if stop != NoStop {
@@ -152,6 +198,23 @@ func SystemSetEnvironmentVariable(vm *VM, target, locals *Object, msg *Message)
 	if err != nil {
 		return vm.IoError(err)
 	}
+	return vm.NewString(val)
+}
+
+// SystemSetOutputBuffered is a System method.
+//
+// setOutputBuffered sets whether standard output is buffered. Output is
+// unbuffered by default, so that interactive programs see their output
+// immediately. Buffered output is written when System flushOutput is called,
+// when buffering is disabled, or when the program exits.
+func SystemSetOutputBuffered(vm *VM, target, locals *Object, msg *Message) *Object {
+	r, stop := msg.EvalArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(r, stop)
+	}
+	if err := vm.Stdout.SetBuffered(vm.AsBool(r)); err != nil {
+		return vm.IoError(err)
+	}
 	return target
 }
 
@@ -169,6 +232,22 @@ func SystemSetLobby(vm *VM, target, locals *Object, msg *Message) *Object {
 	return target
 }
 
+// SystemSetRandomSeed is a System method.
+//
+// setRandomSeed seeds the global random source shared by all coroutines, which
+// is otherwise seeded randomly at startup. This makes deterministic the
+// built-in methods that use it: those of the Random proto, Sequence and List
+// shuffle, Sequence sample, List randomElement, and those which use it when
+// not given a random generator, currently Sequence sampleIndex.
+func SystemSetRandomSeed(vm *VM, target, locals *Object, msg *Message) *Object {
+	n, exc, stop := msg.NumberArgAt(vm, locals, 0)
+	if stop != NoStop {
+		return vm.Stop(exc, stop)
+	}
+	vm.SetRandomSeed(int64(n))
+	return target
+}
+
 // SystemThisProcessPid is a System method.
 //
 // thisProcessPid returns the pid of this process.
@@ -0,0 +1,27 @@
+package internal_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/zephyrtronium/iolang/testutils"
+)
+
+// TestSystemEnvironmentVariables tests getting and setting environment
+// variables through System.
+func TestSystemEnvironmentVariables(t *testing.T) {
+	const name = "IOLANG_TEST_SYSTEM_ENVIRONMENT"
+	defer os.Unsetenv(name)
+	vm := testutils.VM()
+	cases := map[string]testutils.SourceTestCase{
+		"set":     {Source: `System setEnvironmentVariable("` + name + `", "a=b")`, Pass: testutils.PassEqual(vm.NewString("a=b"))},
+		"get":     {Source: `System getEnvironmentVariable("` + name + `")`, Pass: testutils.PassEqual(vm.NewString("a=b"))},
+		"all":     {Source: `System environmentVariables at("` + name + `")`, Pass: testutils.PassEqual(vm.NewString("a=b"))},
+		"unset":   {Source: `System getEnvironmentVariable("` + name + `_UNSET")`, Pass: testutils.PassIdentical(vm.Nil)},
+		"badName": {Source: `System setEnvironmentVariable("", "x")`, Pass: testutils.PassFailure()},
+	}
+	// The cases depend on each other, so run them in order.
+	for _, name := range []string{"set", "get", "all", "unset", "badName"} {
+		t.Run(name, cases[name].TestFunc("TestSystemEnvironmentVariables/"+name))
+	}
+}
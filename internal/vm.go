@@ -6,6 +6,8 @@ package internal
 import (
 	"compress/zlib"
 	"fmt"
+	"io"
+	"os"
 	"runtime"
 	"strings"
 	"time"
@@ -51,12 +53,32 @@ type VM struct {
 	// Coro is the Coroutine object for this VM. The object's value contains the
 	// Control channel and a pointer to Debug.
 	Coro *Object
+	// Stdout is the standard output writer shared by all coroutines.
+	Stdout *Output
+	// Stderr is the standard error writer shared by all coroutines.
+	Stderr *Output
+	// frames is the stack of block activations in progress in this
+	// coroutine.
+	frames []callFrame
+	// deferred is the queue of messages sent with performLater to run once
+	// this coroutine's current evaluation completes or it yields.
+	deferred []deferredMessage
+	// nesting is the number of top-level evaluations in progress in this
+	// coroutine. Deferred messages run only when the outermost completes.
+	nesting int
 
 	// addonmaps manages the VM's knowledge of addons.
 	addonmaps *addonmaps
 
 	// numberCache is a list of cached Number objects.
 	numberCache []*Object
+	// regexps caches compiled regular expressions for Sequence methods. It is
+	// shared by all coroutines.
+	regexps *regexpCache
+	// rec records message sends for all coroutines while recording is on.
+	rec *recorder
+	// rand is the global random source shared by all coroutines.
+	rand *randSource
 
 	// StartTime is the time at which VM initialization began, used for the
 	// Date clock method.
@@ -88,6 +110,12 @@ func NewVM(args ...string) *VM {
 		Nil:        &Object{},
 
 		Control: make(chan RemoteStop, 1),
+		Stdout:  NewOutput(os.Stdout),
+		Stderr:  NewOutput(os.Stderr),
+
+		regexps: &regexpCache{},
+		rec:     &recorder{},
+		rand:    newRandSource(),
 
 		StartTime: time.Now(),
 	}
@@ -116,6 +144,7 @@ func NewVM(args ...string) *VM {
 	vm.initLocals()
 	vm.initList()
 	vm.initSystem()
+	vm.initRandom()
 	vm.initArgs(args)
 	vm.initCoroutine()
 	vm.initScheduler()
@@ -138,6 +167,19 @@ func CoreInstall(vm *VM, proto string, slots Slots, value interface{}, tag Tag)
 	return r
 }
 
+// SetStderr sets the writer underlying the VM's standard error output, which
+// is shared by all coroutines.
+func (vm *VM) SetStderr(w io.Writer) error {
+	return vm.Stderr.SetWriter(w)
+}
+
+// CoreObject returns the object named name in vm.Core, such as the Sequence
+// or Number proto, or nil if there is no such object.
+func (vm *VM) CoreObject(name string) *Object {
+	p, _ := vm.GetLocalSlot(vm.Core, name)
+	return p
+}
+
 // CoreProto returns a new Protos list for a type in vm.Core. Panics if there
 // is no such type!
 func (vm *VM) CoreProto(name string) []*Object {
@@ -245,6 +287,27 @@ func (vm *VM) IsAlive() bool {
 	}
 }
 
+// A Snapshot is a record of the slots of a VM's Lobby.
+type Snapshot struct {
+	slots Slots
+}
+
+// Snapshot records the current slots of the VM's Lobby so that they can be
+// reinstated later with Restore. The snapshot is shallow: it records which
+// object each slot holds, not the state of those objects, so restoring it
+// does not undo changes made to objects that the Lobby shares with others.
+func (vm *VM) Snapshot() Snapshot {
+	return Snapshot{slots: vm.GetAllSlots(vm.Lobby)}
+}
+
+// Restore replaces the slots of the VM's Lobby with those recorded in s.
+// Slots created since the snapshot are removed, and slots that were changed
+// or removed are set to their recorded values.
+func (vm *VM) Restore(s Snapshot) {
+	vm.RemoveAllSlots(vm.Lobby)
+	vm.SetSlots(vm.Lobby, s.slots)
+}
+
 // Register registers a core extension. Each function is called in the order it
 // is registered; extensions that depend on other extensions need only import
 // them. Register should be called from within init funcs. Panics if NewVM has
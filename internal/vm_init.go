@@ -3,18 +3,18 @@ package internal
 // Code generated by gencore; DO NOT EDIT
 
 var coreIo = []string{
-	"x\x9c\xec\x19\xcbn\xdcF\xf2\xcc\xf9\x8a\x02s\b\x89e\xc6V\x0e{\xb01+ز\x03\x18\xf1C\xc8x\xd7\x17\x03\x8b\x16Y3\xecL\xb3\x9b\xeen\x8e,\a\xf9\xf7EU79\xe4\x90Rd\xece\x0f{\xb1\x87d\xbd\xdfU\xfap\xf3;\x96\x1e*\x93\xad\x92\xd6J핆g\x1bh\xd0צ\xca\xf6\xe8\xb7\xca\xf8,u\xa8vi\x0e\f\xf1\x1c\xd2\xcf:\r\xbf\xf3Urk\xa5\xc7\x11N)\x94\x02<\n\xf5\xc2\xee\x1d\xec\x8cEQ\xd6Y\x80~\x0eg\x14{\xfc\tׇ)\x9c\x98/Q{\xf2\x04\xfe\xe9\x10\\\xf8\x00\x95\xb4Xzu\a\xde@)m\xd95G\xd4\x1eL\x8bVxc\xc1\xd5\xddn\xa7\xa4ޯW\x89\xeb\x89\t]\xa5E/̱\x80#H\xf7\xd1vH\xf4\a\xa0\x9f\xce@4\ue15f\x82\xac\xd7\x13\x98sc\n\xb7\xf5V\xea=\xac\xd7p\x1c\x9e\xf2|\xb5J\xa4\x96\x9e\f2\xa0\xf8Z\xba+\xa3=~\xf5)\x01\b]\xa2\xf3ƺ\x91\xd9D\xb1J\x12\xb9\v\xff\xf3\x0f(\x8d\xf6Bj\xf7\xa6B\xede)\xd4Gs\xeeӼ\x00\x8b\xbe\xb3\x1aD\xbeJ\x12\xc6\x15\xb0\x81\xb7\xd2y(\x95ѸJ\x12\xfa\"@\xb4-\xeaYPзy\x9c\x18oN\xae\x1b\xa4\xcd\x04\x83\x8bUBJ0ԃ\xd1\x16\xa8H\xeb(\xd0j\xe1\xae{\x8c\x01T\xba_\xa5\xae>\xec\xd8*\xb5p\xec\xf5\x13I\xa7\x8c\x9f[\xbe\x16\xee\xad)\x85\xe2\x97\x04\x92\x83\xb1s\xffD\xa9?I_\x8f \xa5{/\x15h\xe3O\xae&\x88\x8fw-\xce\x18\x1f\x85\xea\xb0X0P\x1f##02\f\xff\x00\x1fI\xd1G\xb2T\xa2\xf1vQ\xafG\x92\x1f\xbe2\xfc\xb2\xc7\xdc\xe9٧\x14\x91\x84\n\xc2]\x89Vz\xa1\xe47\xac84*\x13\xc24K\xa3$'\xe0\xf5\x1aR\xd8\xc08{_\xf8\xeci\xfe\x1c\x88G~\xc67\xca\xc2\xfa)\xf1\xed\xeeL\xc1\x10\xcaLK\xd8\xfd\x95鴇\xcd\x06.X\x88fl\tT\xbbAz\x86o\xd09\xb1GТ\xc1\x02\xb4T\xcc8\x11TLơӤ\xf9\t\x16\xbfzb\xd45\xa8\xbd\x1b\xc0\x85\xbf\xee|vQ@/\b+\x14҂\x89\xdeO̡\u007f\xd1\xd3ˈ\xd8\x1c\xbe\xcf.։\xa4%\xf1f\xe6\x1b\x14N'\xaa~N\u007f\xf8\x83p\xfe\xfc\x9c\x16\xf0\xc3\x1f#\x01/N\xa5\xe5\xcf<\x05\xa9=\xda\xd6(\xe1\x11\x84{\x17d$\xa2\x13r\xc1XѦ\x1c\x0e\x112k\x82\xf9\xb4T\xa1\x10p}r/J/\x8f\u008b\x1b\xc5R\xef\x84r\xc8\xf9\xf0\xe6\xfc\xd3\xfd\x05pF\xe5\x18C\x85\n\x9c{i\x8cB\xc1}\xc1\xdb\x0e\xe9]U}\xb4B\xfa)U\x8b\xae\xb8'Z\x9e\x16\xf0\xfak\x89\xad\x97F\x83\x15\xd2a\x96Ɔ7в\xf8\xa5\x93\x16\x1d\x18\x8dT\x05\xfc\xad9\x05B\x88Y\xfa\xfalC\x8c@\xee\xdeK\xf5\xfa(T\xf6N\xb4\xa788Eu\x9a\xf7U\x8f_\x1c\n8\xf6\xe5\xf8\xc1*t\xc8\x19\x8c\xe0\x0e$zJE -\xb8\x82K\x1d\xaaC\x90D\x10p\x10$\x9bi\xc7Y\xc4)y\b\xf9(\x94EQ\xdd\x01~\x95\xae\xd7\xe7\xfe\xec\x1f菋FD*\x1e\xc4<,`\xe4\xb1s\x9c\xe1\x84\x10\xa2\x92\xf1\n]i%+@\xe6\x9cd?}\x9f\xe6k\xcfQ\x19\xff^4\xe8\xc0\x19\xeb\xdf\xe8k%J\x8a\xe7\n]\xe9\xfa\xb2\xe9\xa0\x11\xed=\xd5\u007f?*\x8e\x9c*\xb2i\x15\xc6\xee\xbbJ\x92\xc1\xb3\x14$\xbf\xe2\x9d{\xa1\xab\u007fQ\xb5r\x8c\xe2\n`V\xa1t\x89֚\u058c\xdb\xf0\xe1\x96,ue,N\"!\xc8҆\"ڋ:\x88\xd6F\xc5\xce,r\xe9Pa\x19\xc3\b\x0e\x94\xbf]\xc8\x16enі\xc2\xe1\xd0\xe3\xb7\xf8%;\xdc\x06\xcb\xcb]\x16x\b]\x05i\xc1\xc9o\b\xff\x80\xa7\xc1\x8d\xcc}\x8b_:\xd4%\x0e\x85\xa8\x97\xec\x80w\x13\xeb\x0e\x8d\xfc\x10\xb0\x13\x17g\x01\xe2\x99\x02\xa4,\x9b\x92{\xfd\x16w>\xbb\xf8{^p+H\xa3\xa9\xfa\x98\xa2\xd1-\x04`\xf8\x97\xdbF\x9c:\xa3X\xa3\xa7>|\xb8\xf2\xe4!b\xb6]\xd3\b{73\xf7Bz\xf5\x93A\xf6R\x99\xf2p\x1as\x16ư\xb3\x00X0\xceDߴ8C* }\x16U\x9bD\xe1ԛ\xab$9\xdcR\xe2\x1aM\xb9\x1b 7\xbd{\xbe\xdf\xd1'v\u007f\xe1\xaf\xff\xd2[l\x92\x10\xec\x13\xad\x1f\x98\xdcx\x82\xa1\xfa\xf3o\xaeE\xe7\x9f;-\xbft\xf8\xa6\xe2:\xd0\u007f\xbb<\r\xcbC˛\xb4]z\xfb\xe4\t\xbc>\xa2\x06_\x9bn_\xc3%H\a\xbeFh\x8c\xf3p#uEr\xf5\xd3}\xb10\xe7G\"e-\xf4\x1e\x1d\\fMN\x1d\xd2\xc0eF\x9d\x8e\xf6\x04oy]h-:\xb4G\xa4\x1f%V\x1c\vf\x17\xf1[aQ\xfb\x1a\x1d\xba5\\\xd5X\x1e\xc8\xe6@c:\xb0\xb3(\xf1F\v\x87\xf4\x90\t\x15\xa5\xf65F2\xc6ʽ\xd4BAe\xd0\xe9\x1f}\xbe\x8e\xd9\xdb\xf0\xf4\x02ҽnZ\u007f\xc7Ԛ0W\x84\xf13\xbc\x98OFI\x03\x9b\xf0a0Y>\xf4\xfa\xde\r\x81x>\x84\"\xf7\xb3\x06*\xf3F\xc7\x1d\x83\xa7\xaa8\xf58\xd4\x15Z\x8e6.\xf7\xd7¹\xad7\xad˨-\xf7ռkў\x15\xf0r\xf0` \x11\u007f+\xe3#\x97\xfb\x9aXlс&!a\x05\xa6\xf3NV\b\x02n(\x9dC\x87\x99\xc7ȣH\x0e-_\xe8\xa1\xd5\a\x82\xfd\x10\x16,\xc4K\x0f\xa5r9\xdf\x05\x06\vF\xf3\xdd\aCy\xb53\xf6V\xd8*\xb0\xe0\xbdLN62W\xe6\v\x83J\x90\xd5\r\r\x9d\xa9qO\xd7\xc6\xc3\xcet:R\xbc!\x19\xc5\xe0]\x96\xec\xac$\xde̦\xadM\x9c\xd9\n\xb8\x19uH\x82k\xd1\xee\x8cm>\xe8l\xe6;/\xec\x1e\xfd$.\nh\n\xe0\xad._\xd1x\x82\xba\xfa\u007f\x18\xfc/\x85\xc14\x06\xc6\x19\xff\b\xaf\x9f\x15\xeeb\xc1\x8f\xb3(X%r|\xccQ\x05\xa8\xa1uq]\xe1\v\x87\xbb\x95\xbe\xac\xa7\xa1\xc2~\xdc\x19\x9bQ\x05-hz\x9fN\xf4?\xc1\xcf\x05\xfc\\\xc4\xda\xf9\t\xa1\x14\xfaG\xdf\xf7\xf5\x1a-\xc2\r\x96\xa2s\x18\nq\xa0\f\xadp\x8e\xa6E\xaaY}}=۲\x88aN9\x11\xeaލEq\xc8F\x11\xc5%\xfdop\x11\x9an\xf4_C\x1e(\xc6\x04\aI\xa5\xfbP\x85}9\x99q\x9ajt1\x9a\xadÊ\x95\fg\x98ʜv\xb0\xb8{-\x15`Z\xc7ފN\x97\xf5\x96\xa7\x8d\xf3KZ\xbf\x96*q\x83\x8a\x94\xdc\xde9\x8f\r\xa8\x11\x0eQ\xb1\xa8\x84\x97G|e~\x91a\x1f\xab\xcco\xf1]\xff&\xd2\xe51\x96{J\xc5\xc0ٵ\xf05\xdcJ_/\xb1l\x85\xaf\xafL\xd3\x1a\x8d\x9af\xe0\xbc\xdf\xfa\x89\xff9e\xe1\xeb\x82\f\xc4K\x1d/\xe1!\x97\xf9\xe7\x06\x94\xe4[P\x12u\xa8L\x16Ȱ\x00\xcf6\xcck\xca\xf0\xf9D\xd1\x1e\x86h\xbc\xe2㠱wdի\xceRK\xffd\xecA\xea\xfd\xf0)\x9b\x18\xeb:b\x8eU\x8f\xd4B\xe0+\xa9\xf1]\b\xbbyhOL3=&0\xc8\xf9\xf6\xdc7\xd8\xc9\xd5 p\xaa\x90\xa6\x12\xe1\xb1\xfa$\xac\x9e\x0ecBqT6\x8bu\xf7\x9e\xc28\xa7\xd7t4Qa_xw\xd64\xec`\xa9A\xc0\xcbX|{mz\x93\xc4[n\x96\xf6T\xa4\xa6\xb1\xb2\t\x81@crx\f\xd7\x06\xaa\x81\xca\xd3\xf0)\x1d\x9c$X\xf3\x05\x97\x8b\x9dP\xf1\xac$\xb5\xf3(\xaauZ@:\x05N\xfb\xaa\xe3\xd0\xcap\xa8\x1a_\x87\xbcE\xd1\xc4\r!<\x84\t\xaa\x80\xf8ģ~\xc0\x14d\x91mx=\xac\xf6\xbfw\xceo\aґ\x06\xc7@\x00\xbc4\x9do\xbbp\xa7\x9b\xc2.\x8a\x11\xb1\xd8P\xb3\xb1y\x8f\xfet\x11\b\x17\x80\xfbj\xf6\xf8D\xca\xe3\xf6\xb8\v-\x8d\xe3<\xe4\x87]\xa62Y\x1c\xed\xe7\xdb|/<\xbdvsm\x83\xdci\x1e\xf0\xf3\xb1շ\xfd\xc2>\xd3\xf9/\xb8P\xa3\xe4\x85~&\xf7\xb0\xea\xf7\xdeZ\xe49\xe0\x8f\x98\xeb1V\x11[\xfa\xe9 \xcd\xf1\xc7s\xf1D\xad\xcf>-bl\xa6|q\x9b\xde\xec\xce\xee\a\xa1\xf7\xbb\xa5\xd88\xa7;,R\xf9*_\xad(\xad\xc3_\\\xe4\xee#\xfd~\xb6\x818\x99\f\xbc\xfa\x8e\xa1\xab߸\xbdm\xe3\xe9\xc4ר\x1f\x03\xff^\xaa\xf4\x11\x11\tSAI2J\xa8|\xb5\xe2鰗\xf2\x17~\xf8\x0e1\xf1\x91\xf0QL\x02\x97\xbb%\x04\xc9\xe4\x86%\xd1ش\x80{\xfe439\x19ƃ\xe4w\xea\xcfX\xd1\x00Z\xaa^}ڸ\xbeC\xf9!\x11\x1f@\x8ap\x9c\xd9\x04ƽ\u007f\x9c\xea\xc3\xcbG\xe9^\x8a8M1\nM<\xc3\xc3\xc4,\xfc\xe6;\x8d\xa2\xc9Gl\x92\xa5ByRq(.\xa7\xbf_\x8d\xd6\x00j\x0f\xa1T.\x9d\x9e\x1e\xfdw\xc4c\x01cR\xa7\xc3\xc61g\x19\xff\x13\x00\x00\xff\xff1\x8e,t",
-	"x\x9c\x94\x93ߪ\xdb0\fƯ\xed\xa7\x10\xb9\xb2\xa1\x1c\x0e\fvq\xe0l\x84\xb2\x8b\xc1N(t/\xa06j\x1a\xf0\x9f`)e}\xfb\xe1\xa4k\x9d\xae\x1d\xecʵ\xfa\xe9\xd3/\x92\xbcF砍F+L\xdd:\x8eA\xe0\xed\x1d<\xc91\xb6\x86\xc9\x1d\xc0\x133v\x04\u007f\xfe\xb7\x93\xb4.ua\x05\xf7\xd2ZL\xb0V+:\xa1\xab\x9fșBK\t\xda\xf81\xe7\xfdUo6\xb1Z\x1d\x91\xeb\xd4q\xe1q\xa5\xfd\x02\xaf\xb72\xfc\x0fx\xfevB7\xa2P\xfb=\x98\xa2\xbe\xb5\xc0$\x1bd\xdeJ\x1c\xd8H\x1a\xc9j\xadZ\xe2}\xea\a\xe9c(\\\xb5R>_Kw\xad\x14_c\x82\xa9#\x019\x0f\x04//PA\x95\x0f\x0f\x01\xfd,D\xd7w\xe1\a\x1d\xc4|\xfal\x17\x12\x87;r\xf0\xd58d٠\x1c\xd7\xd1\x0f1P\x90;Y\x1f\xa8\x19\xfd\x8e\x92V3\xa8\xa3\x0e\x85~Ƃs\xc6XA\xe0\x95V\xea\x02\xf5\xa4\xd3{\x17\x03\xe5&4\xf4kJ\x81\xfe\xd0\xf4.\xf7k\xd9(\xad\x1e\xf6\xaa \xf8\x98\xaa?\xe2@O\xffI2\x9d\xe8\xc9\xe4\\\xbbؙg$Z%b\x92\x1c\xda\nʸ܇\"n\x9a\x98<\xba\xfcI\x89\x1c\x9e\x1ff\xe4qI\x1c\xa6\xd1\xde2S\xbe\xef\xf3\xb3\xb9\xee\xb6y\xcdFj\n^\x96\xfa\xf2\xbb,\x99=\xb2\xac#ٺ(\xa6J\x95\x9d'8-\xcb\xdb;T\xf95V\xda\xea\xdf\x01\x00\x00\xff\xff\xa3\x11,u",
-	"x\x9c\x8cX[w۸\x11~&\u007fŔOd\xcc$\x92\x9cx\xbdݣ\x9e\xe38N\u05ed\xe3uW\xde\xeb\xc9\v$\x0e-\xd8 @\x03\xa0.~\xe8o\xef\x19\x80\x84HI\xd9\xe6\x85\x04\x81\x99\x0fs\x9f\x91f\xf8ܠ\\ \x14*\x8d#ff\xbc\xaa\x05ά\xe6\xf2\x01\xfe>\x85\n\xedR\x15i\xf2%I\xe0\xcd\x1b0(J`\xa6=g\xe6sc\xd9\\ \xa0Y\xb0\x1a\x89\x82(\xb38\x8e\fڙP6M\u07bcI\xf2\x0ef\x95\xef!,\x84\x92xQ\xd7(\x8b\x19>\xa7\xabp\x92eY\x0f\xe3\xd5\x00\"\xc8\xec\xb8a\xa1\xeamJ\xb0\x19\xbc\x9a\xc2j\xc8\xf8͜\xaf\xa6\xe9jx\xe9\xc97\xb2\x9e\xec_\xfa\xfa\x1b\x19_\xef3\xbe\xfdFƷ-\xa3V\xca~F&g\xcf\r\xd3\xd8sW\xb5\xdb4\xcfڒ?\xb8\xb9\xaaj\xbb\xed\x119O\x18\xfe\x820\x9d\xc2(#\x92ٶ\x9a+\xb1OÃ\x9b\xa5\xf2X\x16\xabKU\xf7\xc1\xbe*,\xd13\xc1\x1f\xe4\r\x96\xf6Z\xde\t\xb6\xe8K\xbaΡfE\x1eG\x912\xb4M\xf2\xc4Q\xc4˴f\x05ps\xcb\x05(M4;Q\x1d\vL!\x81$\x8b\xa3(M\xd7\xf0ڝf\xf06Pf\xb0@.@c\x8d̦,\x84X\xcd\n2\x9d3:\u007f\xc1t\r\x15ۤ\xca\xd0f\xd6\x13\xf5P\xc6^\xb8\xef+Ԓd\x1d\xc0\xcf\xfcay\fa\xcfJ\x870\xad\"\x1e\rvR\xb7\xa6\xf4\xe8\x97(-\xeac\x02\x86\xbb\xd34u\xe6:\x81u\xf6v\x92A)\x94\xd2\x1d\xeaW\x85\xa7\x02p\xc9jn\x99\xe0/X\xf4n\xe0e\x1a\xa2 \x87E\xa0\xe9\x1be\xb7KY\xec\"ɉln\xd4\x1a\xf5\x82\x99\xbe\xdfwl\"\x9c\x06.b\xfa\xa5\xae\xff\x82\xa9\t\xa7;\xa68Z(i\x19\x97\xe6Bn/\x99\xc1\x19>\xf7C9Ԟ\x9d<\x1d\x833q\xff$s\xe9p\xf5\xdc0т\r\x91x9L\x1fӺ\xedP\x82\xd4dβ\x82K\xbc\xd3\\\xf6\xc3\xe2\x13\x17\b\xc62Y0]\xfc\xd4غ\xb1\xb0\xd6ܢ\x03\xcf!\xf9\"\x93\xec\a'\xb6w\x8ec\xd8\xe3_s\xbb\f\xf1Qr\x81\xb7\xac\xea\x13Q6\xb5\xb9\xcf\xcb{\xdd`\xaa\xd16Z\xb6\xb0QT\x13\xb1`\xc6\xde1\xbb\xbcTU\xad$J\v\xa6\x16\x9cʷ\xcb1\xcaG\xaf\xec?`\x9cC\r\x1a+\xb5\xc2\x1bf\xac\x87xT\\\xb6\xc4T\xd1\\\r\xff\xb0\xbd\xda\xd4L\x16\\>\xdcsQ\fL\xe8\xc1\xff\x9bd\x9e\xd3ҹ\xa76\xf7\xca\x19\x8cS\x90\xd7J0\x8b\x87e\xc3\x19?\x14\x19\xe8ђ\x15*\xf6\x84\x9f\xb86\xf6r\xc94[X\xd4\xc7B\x90|\xe85\x1a\xe5\xc0\xec]cS\xb7HG\xd90\x14\x8e\"\x1e\x8bϿF\f\x1c\x0e\xb1\xa6\xca\xe4\x92{_-f\xaf\xa5Am)xF\x94lB\x00\xae\x98\xb8\xd0\x0f\xc6Y+\x84D\xa4\xb1&\xc3|fu\xbf\xf8\xe7PA\xa94\xb2\xc52}ʡk\xba-1\xc1\xd2n\xd7\xec\xae-V\xe6^ݨA\xc3ߴL;\x82\x8f\xaa\x99\vL7\xa0U#\x8b\x8fj-ۊ\xf1#n\x86\xe1\xe6\n\xf9\xb0Թ\x82+\xca \x96\xce\xc1\xf4ʛ\x06\a\xd3\xd6\xe0\x92\xcb\xe2\xd6.\x0f\xd2WR\x9bx\xa2=\xa2\xf0\xb9\xe5vxy\xcbE\x17֒\x8b6b%e\xe68\x87\xf6\xe0\xc9\x13\x9fx\xc5p3\x13|\x81)m\x8c;e]\xdf\xd8]\xef.\x85\xd70\xf6r)]\xa0\xc6bF\xb1\xbb\xa71\xd6N遯\xbc\x10\xee\xa8\u05faZa\x0476dmĉy\x14GѦ[\xb8\xf2\ue232\x0e\xbf\xb3\x9d\xc1\x9a\f\x11=\x0e,\x81u\x0e<kMA\xc7\xd1\x06\xa6\xb0!\xed\xdc\x17U&.\x1b\xf2\x04\xe9B7x\xfb\xa7\x9d%x\x0e\x8fN\x1a\x12{\xe3\x83x\xd35\xd0@M\xe6\xcd~\x00\x02\x1fyb\x98£\xb3j\xddu\xef\xd6\xfe}\xad\xfb\xec\xbd\xcfp\xb7\x8b\xc6\xe8+ב=\x9c\a\xfc\xe9\xa03\xb9\xa08\x1eq\xb2C3G:\xa9C[\xa16\aee\xd7eZ\x82\xb6\xfcP\xba\xf0\x17\xbc\x96\x1f\xb6\x16M\x9f\x8b\xf4|\x054\x15\xd1HAt\xa4\x94\xf9\x80v\x8d(\xfb\x94\x96i\x9b\x03J7\U0004804f\x9f:ׯ\x97\\`\xca\xdc4\xe4r\xa6s\xb1g~ʜ\xf7\xe7\a\xe7\x84\n.\xbe\x89\xd0\a\xb3\xa7\xf4A1\xd7\xc8\\\x0eD\xa2\xb3\xee \x13\u06005\x87\xb9\x0f\x86'\x98\xc2\x1cNH\xe8\xbe\u007f\x85/\xf4\x94\v\xb7\x8a\xda\v\x1fڄ\x90kԥ\xd2\xd5o\xdc./\xf4\xc3\ri\x9a8\x8ed\xaf\xace\xa4\t.\xc2D\xe2,\xfa7\x8a\xb0,\x8e\xa8\xc1\xfd\xdf\x02s\xf4.\xaf\xe5\xc1e]b\xf6G\xdb\x1c\x8c+I\xbb\x11\xc4\xff\x88\xf1w\xccк;\xa9\xd0\xfa\xeb\xdcϥ\xc8\xd7\xf8$\x8c0\x9dLI\x0e\t\x9b/\n,\x1f\x96\xfc\xf1ITR\xd5\xcf\xda\xd8f\xb5\xdel_\x12j/\xdcw\xce\x16!\xcc3}\x84\x8b\x0f\x97\x1f\xaf>\xfd\xf3\xc7\xeb\u007f\xfd\xfb\xe6\xf3\xedOw\xff\xf9yv\xff˯\xbf\xfd\xfeǟG\x10\n\xfe\xc0m\x9f{4\x9e\x9c\xbe{\u007f\xf6\xdd\xf9\xf7=j2\xe7\x92[\x9c\xd5\xd4\t\\\x976!\n\x93/\xe4\x18\x1a:\xe8\xb9r\xcf\xd2=5=\xc1-7\xe7\xef\xfd\x9b\x8d\x12\n\xc4\xe4K3>;\x1f\xb9\xbdf2\x1a\xedV㰚\x84\xd5iX\xbd\v\xab\xf7au\xd6!NF\xa3\xef\xc2\xeeyX}\x1fV\xac[M\xc2\xe9$\x9cN\xcan\xf5\xbe]\x9d\x92\\N\xfb\x15\x13\xbc\xb8\x92\vUt\xaa'\x8d-ϡ\xb1\xe5\xf8\x8c\x9e\xa7\x13\x10\xccr9\x06\xd9Ts\xa4δ\xe0<\xf1\xe3P\xcbO\x1d\xf1~[c\xcbϥ=\az\x12\x02\x97\xf6t\xe2^g\xef\xc0\x9d\xf8\x03\xbf\xef\xb7K\xa1\x18}\xb9\xf7ٻ\x0e<\x8e\xfa\x8dԍ\rY\x1cm\x87{c\xda{\x19\xeeM\xdaV\xfe{o{՛@V\xbb\x89\xc1\xa0\xfd\xe3\x18\xd5x\x9f\xea\xcfcT\x93}\xaa\x1e\xd1pN\xe9\x9a\x15w\xf3\x87\xe7\xe6\xed\xd4\x11G\x8f\x8d\xb13\xd4\xfc\xe0w\x86\xb1\x1aY\x95\x83\u007f\xf7\x86a\x18\xfe5\x91eq\x16ǿ\xe2\xc2*}\xa4\x18\xb4S\v\xf9(MZk'Tdl\xe7\xfa4\xf1\xeeM\xb2x\x15PB\x85Y\xd1W\x8b\xdev\x93\xe3\xeamrXu\xc5ԍ/\xab8\x8b\xff\x17\x00\x00\xff\xff\xbb\xe1i\xb6",
-	"x\x9c\xa4S\xc1n\xdb0\f=K_A\xf8d\x01F\xb1\xec\x98!\x03\x8a\xa2\x05\x86a\xcd\xd0\\wQm\xda\xd6&K\x1eI7\xfb\xfcA\xb2\xe3\xa4M/\xc3\x0eA \x8a||z\xef\xf9\xfeO\x8d\xa3\xb8\x18\xa0\x89\xa5V\xb5\x9d\xba^\xbe!\xb3\xed\x10\xb6\xdb\x1d\x04絪#\xc5I\\\xb8(\x05d\xc1\xe6<\xbe^Dr\x9d\v\xd6\xdfY\xefתV\xdc\xc7\xe3Al\xfd\v\xb6;\x18P\xfaؔZ)F\xdf\x02\xe7:\xe1\v\x12\xe3C$\xb4u_\x0e\x95VJ\r0\x12\xbe\xb881\xb8\xf6\xd1\xf94\xa3ԃ\xf3\x98\xa6Bc\xa9\xd9O2N\x02Gr\x82e\xf1C\x8a\n\x06\bv\xc0\nN'o\x9f\xd1WPl\xe7\x93\v\xf88\r\xcfH`\xf9 \xe4B\x97ZCa\x12\xb8I\x8bb\xf8\x87]+\xc3e)\xfc/\x03\x9d\u007fF'?\xa4\xee/\x14\x1b)J\xac\xc0\xb5e\x16\xce\xf1W\x17\x9a};\xd7M\x05u\x12\x1d_\xac\xbf\xa5\xeeVʍ\xf9\x94\xe4\xaf u\x1b\xa3\x8d\xd6\xf7D\x91\x12\xe2\xfe\xf9'\xd6\x02\xb5\x8f\x01g\xf3]\xbb^\x9e\rrm\x99A-uwq\n\x02\xbb\x1dl\xb25ov}ȴ\x01=\xe3\xd5\xcc\xe7\xcb\x11\xc6\xd0 \x01\xa3\x1c|\x94\xb9sX\x12g\x17\xa8E\xbdL\xfb\x9d]\x9b\xf3\xael\xd29\x86d\x1dcY\x9c\x9eB\xf8{r\x84\f\x1b\x88\x04\x1f\x13\xfe4`\x10.\x16\x91s\x00\xb52\x89\xcew\xcb|\x908r)4a\x92\x9fP&\n_\xaet\xb9|\xc7\xdc3S}\x0fEeJ\xd7\x18o9/\x12\x98\xb4\xf7\xe8\xe4\xd2\xf5\x81\xbb$\x1fR\x9e\xcf\xcegۖ\xdaI\xbc4\xc0\xddR\xf4\xb1\xb6\U000c7e43W\x12\xe7$\xc2\xcdM\xcab\xfa{}\xb9\x06s\x86I)\xccl\x0e}<^3*\x8b\xfb\xa7\xa7\xfd\xd3\x162\xd2\xc0\x9d\x81\x91\\\x10\x1f\xb4\xcac\xa9q\x89\xb2\xe3U\x81$\x8c6\xfao\x00\x00\x00\xff\xff[3c\xce",
-	"x\x9c|\x90?O\xe40\x10\xc5k\xfbS\xbcҾ\xf3\xea.W\x9c\x10\xd2\x16l\x05\r\x14)\xa8\xbd\xd9\t;$\xb6\x17{\xb2\xb0|z\x94\x84?\x11B[Y\x9a\xf9\xf9\xfd4\xefv\b[\xca\xd8%\xa3U\x93\u0096\xa3\x17N\xb1\xe0r\x8d@\xb2O;\xd39\xadT\x1c\a\x85\xfa\x16\xbfQi\xa5:\xac\xd1!p4\xd3p\x85\xcej\xa5\x8e#5\xae۔\r;T\x0e\x9d\xc3\x11k\x1c\xf1\v&b\x05\xb6\xf8\x03\x9e`\xad\xacV\a\xcaa\x90\x9f\xa5\xdf\xe3\xfe:t\xabj\x11\xf8\xeef\xfb\x99\xa7\x95/\xd7\xf4\xb2H\x92\xb4\xf1\x85\xee\xf7\xa9\xa7\xcdI\xa8\x98\xea\xff\x88\xfb\xb2\xe1\xe8\xf3\xe9\x1c\xf9o\x06\xef\x1a\xf1\xfd9\xee\u008eb.7\U0006a9a7\x81bC5\xc9\xe2\x87V\xeac\x81\xf2E\x14\xb4)\x93o\xf6\xa6\x908pk\xa6>\xc5:d\x92!GH\x1eh:\xae\xf5}\xa1\xf9\xc0ǡHM\x99}ϯ\xb4[X\x8ad\xf2\xc1a~\xf1\x9cYh\xaeȗ\x9aá\xa7Z2\xc7\ak\xb5\xd5o\x01\x00\x00\xff\xff)\x82\x96\xc4",
-	"x\x9c\xecZ\xeb\x8f۸\x11\xff,\xff\x15\x03\xdd\x17\tQ\xb6\u07bd\x00\x05\xd2\xdd\x00\x9b\xe0\x0e\xb8^\x92\v\xceE\xfb\xa1\xe8\a\xae4\xb6y\xd6kIڑs\xe8\xff^\f\x1f\x12)\xcb\xf6\xe6\x89$(\x90\xacmr8\x9c\xd7o8|\xbc\xe4RA\xd1$\xb3hɅT\xf0\xf4\x06*T\xeb\xa6H\x98J\xe6i:\x8b$\xe6M]\x84\x1d\x97ԡ\xd6\\\x8cگ\xa8\xbddc>\x92\xbfCx\fz\x94\xc0\xa0W\x96<GÏ˟\xaaV\xed\xfdN\x1aws\x03s\xdd\xfb\xbaQ\x93\x04Ϩ\u007f\x16\xe5M\xebw\x95\x19\xa0\xa6fm\x8bu\xb1\xc0\xfb\xa4Գ(\xac^\x84\xa4\xda\x06y\xd9\xd4\b\xc4$\x91X.\xb5\xe6\x8dP\xe7Ȁ\x88~\xa9ߔ,G;\xe4\xb9\xcf\xfb.\x83\xb3\xe3\x9e\xef\x93\x15\xaaE٨$\xbe\x8bSc\xa5\x1d\n\x89gg\xb7t\xbd\x00\xb3h[\xf3\xfb\xad?p\x16E%\xfd\x1c\x18̢h\xd9\bd\xf9:\xd9ePZ\v\xfd\xb2\xbc\xbd\x93X\xabdG\x02\xa4\x8eӋf[\xfbV\xb0\xfc+\xd6\xea\xc1\\*\xfa\x94Xb\xae\x92\x9b\x1b \xfb\xa6@\x8e!Ef\x11\xaf\x15I\x98\xab\xd07\xa5\x1b\xb2\xcb oj\xc5x-i\xe6t\x16\x15|\xb9D\x81u\x8e\xe1\x90a@\xe9\x0f\x81\xbaQ\xa9\x11\xb7\xa9\xc3\x11\xbd\xaf\xbd\x18\x80\x81\xbdu4I)\xb0\xd8\xe6#\xab1\xb1ʩ%ge\tL\xac\xb4%fQė\x89\xee\xa2\xc0\xcc\xe0\xa7.\xc7V\xd1Ԃq\x89I\xac\xedl\xf9U[\xa9\xe0\x0e5\v,\xe0-Wk\xb8\x04\xd5\xc0\x13b\xb8\xad\xb0V2&\xe1}\xa6W\xd0\bp?\x9ed\xb3\xc8:\x90\xa4\xa5\x1f,\x1fĒX\x17(\xa0h^\xa1\x94l\x85\x89\x13\xf6V\x19~\x16u\x91\xc7\xc6\"\xcec\xa5\xa1>\x8b\"\xb2D/\xc9\xf5\r\\\xe9QL\xac$Qi_\u05fc\xd4#+\xdf2\xb74\x1ejV\xa1\x99\xc5EW\x97\x01\xcdp\xa3\xff\xb6(\x96\x8d\xa8\xfe\xc5\xd5\xfaV\xac\xc8JI\x95\x81f\xceԛ\xadJ\xe6\x19t\xe9 ,\xcb\xf3\u05ec\xc2\xe3\xf3t\x13ݗC\xf7X\xc2+-w\xae:\x1d\x89\xbf\xdd\xfdA1i\x00\xd5\n\xa4\xf8x#\x1a\xd5$\x9e]\xf5\b\xbe\xf4\x9b`\xcd\xe4\xcb&g\xa5\x81+\xf9$N\xb5\xbc\x86\xb5t@\xd6=Y\xe0%\x1dnD\x9a\x8e\xcdt8\xdej\xaf\r\x98\x1evw\xa6\xb33]\xc6Ț`\x88\x84\xaa\x9f\x8a\xfe\xb3<נ\xb6\t\xe3\xf7O\x1e\xef>ۯ7\xec\xe7\x19<\x0eB\x9f\xb8S\xfc\xeb\xcf~\x9d\xfa\x84X\xb0\x96\xf9\xf9\xff\x90x\b$\x0e\xac\xf5ŐA\x85\x0e\xad/v)\r\xa1\xf1\xde&z\xa8\x85\x1el\xa0\xf4#\x01\x1a*'\xf0~\xcb\x05J\x83\xca\x1fǨ\xd4U\xdd<\xe4|\x99\x82Zc\r\xc9t\xc4S\xab\x86\x0f\x19\x85\xa9dc<G\fT\xb2\x81\xc7P\xa4\xbe\a2\xed\x13\xbdv\x1b\xc2H`\xd5\xec\xf0\xd6\x11\x9b\xc6\x02n\xa0\x80Gp\xa9\u007f\x0en\x03,%\xfa\xb9\xc3\x17nW\x1f\a\xc8X\xf0\xcbc\x82\xef\xec\xba\xe8I\x13\xf8jWg\xb0K\x9d\x8e\a\x01\xf6Q\x8a\x1956'\xd48P\xf1,\xc8?@\xc5M\x9d\xc1\xe6˩>\x8b\"\x93\xeaS\a\xc5\xef\x13\x83\xe7\xc07*\xd6IEV\x96M\xce\x14\xfe\xa3Y\xf0w\xa8w=\xe9\xfb\xa3\x93V\xb8\xa1\xeew\xfe\xebw\x1e\xbbx\n\xa1֓n\x93\x10\x90\xa7\x9f\x19\x95\x13\x02\x1f\x04\xe2\xa1<S1\xf9\xdeZ|\x16\b\xfa\xfal2\x98R\xe9\x14\xe6>\xbd\xaa\x14mf\xed+P}\x87\x80\xb3Z\x9d\x01\xdc\x17\x84\x91@\xb5\x15u\xe0\xc9o\rC\x0fT\xe1\x9b\a\xd0y=gQTs\x8b\x9f\x8a\xb5\xdfi\xe1\xe8i\xf6\xf9p48\xd3\xec{6\xa1\xb3&\x00\xf5e\x10s$Ȧ\x83\xa9\x17\xfd \xa4\xbeIl<D\x1d\xbff\xabX;\x8a}\x92\xb1\xc0\x12W\xbatye\x8flIP\x8d\x83\f\xe2!\xb8\xe2\xd4\x00i%\x9am\x1b\x9c\xa0~\x17(rj\x9d\x81\x90 \xfe\xafX۟\xd3~\xfc\xda$\x80)w\xb4K\x0e=\x83+`r\xa1\x04\xafW\xfe\xb9uz\xb4\x98\xf8\x8a֬\xb1\xa2\x87\x1b\x93\x0fU\xed\xdb\xc0\xebgџbҞ\x8el+\x0f\x94\xe6|;yD\xa4l\x87\x82\xad\xfc\x85\x8fh\xffb\xee\x00\xf4\xd9:\xed\x02_\xda[\xa1\xb6\xf1\xd3\x04_&\xc3\xc5N\x06\xfd\x86\xb1?\x89ˠ楹\x11\xa1\xae\x9fG\x97T\xc7\xc6\xcf\xc7\x03\x17x\xef\v\x98\x81\xf4B̜\x02\x1a\xc2\xd0\x10\xfar\xe0\x8f\x86\xfb\x17\v\x12\xdb\xccau\x81\xf7[}S\xe1\x00+\xb1\x05\xbe|\xddԯy\xa9\xc3F\x8ft\xeaPáӅw?1l\xac7p\ru\x06~\xa7\xc4֏M\xbet\x93x\xaa\x84\xccFN\xe4\xb5D\xa1\x9e#\xd1{\x1a\xed2\xb8\xd3m$φ:x]`\xf7\xdb21\xcd6\xa5n2\n1\xcdBK\x9ff.xܕ\x91\xe1\u007f\xbbT(B\xf6\x8c\x9a\x0e\xb8\xeb\xd6)\xe6\xf0\b.OM\xa0B\xee\a\x82\xe9\x92,p\xda\xecH\xce\xd4r\xea\xabOM1iR\xbeLvp\r\x82\x8c\xfb\xf4\x06\xacUC7\x8f\xf2UG\x84}$\x89\x83T;:P\x8d\xf6\x01\xfdaj\x1e\xd3\xf3e\xb2\x87k\xe8\xacH\xfbC\xc4F\x15\xeb>\xa5\xfeϾ>\xfd\x9f\x9d\xd2\u007f\x161i9L\x98\xc1\xf5L܇V\x94goݺ\x9cT\xc3\x1a\xed\x821\x18L\xd4/X\xbe\xc6\xe2w\x94\xdbR\x8d\xf3G\xea\x84Y\xf0\xaa-\xd1d\xe0P\"\x11\xdcO\xfc8O\xbd\xa2;\t\a\x0eIܠF\x80\xbd\x02\u007f2'S`\xb7\xe8\xd9\xfc5\x85\x8b\v\x88/..\xe2\fD/\xc6\xdfepK\x1a\xff;&2\x9d\x00+\xd6&\x86 \x05\xcayI\x9cņ\xc9\u007fbk\xd0q\x8dW\x8d\v\x16gȖqA\xb64U$\xfd2\xb7\x8c\x19\xb8\uf5fdmh5z\xb1\x15\xb2\x11\aE\x9e~\x99\x10\xe9|\x01O\xed\xa1tޔ%\xe6\xbaĢ&\xbd\a\x8bj\xecF\xc7\x17v\x94\xcd6\xee\xc0Ѣ\xc2\xe3\xe1\xe7fZN4\xf53\xa8X\x97\x81cQ\xb1\xeeo\xb0d\xa5\xc4\f\x94آ\x8b\xb4V\xe0\x8e7[yr\xe61\xefk*\x13\x1d\xc9|\x92\uf395\xc1\x1d\xbe'.S\x86\x8d\xd9r\xe9\x9c\x17&À\xd6&E=bT4h\xa4\xe8%oz\xa2~-\xed\xa7KgQ\u07bb\xc9{\x96`}7\xc0\xa1g\xe2\x9eR\x98\x87\x11\xbf⨮\x97\xf9\xfa퐔r\xc5wLa\x01\x8b|\xfd\x96\t\xf5\x8e\xb3:x\xb4\xe0\xaazW{\xeb\xaaX盻\xa6\xd8OV\xc4=\xaeYi\x12\x93^\u007fv\xac\xf4\xf2\v\r\x9eH1Z8V\x14o\x18\x17zUae\x9fe4\xaf\x1d+O\xdf\xc6\x1dL\x1e\xee,l1g\xb8\f\xfc\x8d\x88\x9a\x14w\xac\xbc\xf5*\xe3\x932\xa5֠\xb3(\xb5\xb0\x19\xdbq\x1a\\\x11\x01RzP\x8ax\xcd\xfd\x982\xfd\xf6\xceS;3`M\xb2\x1cc\x1dm\xc8\xe5\x03g\x17\xd8^\xcb\xd1\xc4\x18'?\xfcI\xc3C\x82\xff>\x85\x1f\xfe4\\F\x1d1\xe8\xa7&mS2\x85\xd6*\xf4ך\xcb\xe3\xac+/0jٔ>֧\x8f\xe5_q\x9flR\xfa\xf6O\x9a\xd4<Q\xd1\x05|\xf88\xa8?N\x1a\a\xe8ܞ'\x99\xe9\xcc\xfb\xa0\xe4\xael\xf2M\xd2e\xb0Ϡ\x03\xd2\xf2\x1a\xf6\xf4iky;\xe6x\xc0GQd\xea\xe8\xe9\xc8;vS}^\x8e\x89+\xe9\xa6\x1d\xdfI[Q\xad\xb0Nڨ;\xb1C\xa25\xfe\xc4&IǶ랒ԶE\x9eĮ\x89F\x8e@\xd5\xd5V\x9f\xf4\x04Ѿv\x9a\xf8D\x03\xe6\xae\xfav\xf7\xc5~ڃ@\u007f\x95\xd6\x11\xe9C\xb1\u007f\v\x16&\xbd\xe3g\"\x19\xc46G\xc6\xe6q\x98\xdeyl\xa5Z\xa0\xe0\xac\xe4\xef\xd0\u007fk'\x95@V\x91\t\xcc7x+\xb8\xc2$\xd6ގݹL\xbf\xc5\xe1n\xaf\x11\x94X!w\xcb\xd3\xecN}\xa6\xb4p\xc15\x04/$2\x88Ϳ4N{\x8d\xd3\xd9\xff\x02\x00\x00\xff\xff\xd7r\x9by",
-	"x\x9c\xecUMo\xdb8\x10=S\xbfbV'\n\xcbu\xe2\xec-\x8b,`\x14)\x90\xb6n\x03\x18\bze\xad\xb1͈\"\r\x92V\xec\x14\xfd\xef\x05G\xa2-KI\x83\xde{\xb0-j\x86o\u07bc\xf9\xf0\\n\xa1\xb4<c\x1b\xe9\x1f\xa4\xde!\\\xdf@\x8dacK\xdeĳ\x00\x8fz\x05\xf4\xecaiM\x90\xca\xf8\xd6V\x14\x19\x93e\xf9\x11\x0f~fʇ\xd6\xe5t\xbf\u0083\x17\xddM\x911\x16ϰ\xb2\x0e\xe5rÕ\x80\n\x0f\x1d\xba\f\xf7\xbb\xc0\xe9\xdc\x05\x92\x81\xab\"\xe2\xb3萱\"\xcbؓ\n\x9b\x1e|\xc6X\x1d\x8f1\x85\xa5\xb6\x063\xc6V\xd6E\xe4K\x01K\xa95H\xb7~gw&\xc0?0\x15p\x15I\xb0\xba\x8bF\x0e\xd8H=s\xebY\x8c\xd6\xdd\xe9\xbd\xfa{J\f\xe2\xa7n)H\xff\xc1[\xd3#\x91\u007f\xcfa2i\xb3\xa0\xfcj\xb9啀\n:\xd7\xc9\x04\xf2듏\f\xbc*:[\x01\x8fV\x19\x9e\x8b\xbc \xb7\x1fy\xd4\xd3\u007fR>\fTL\xa8Z\xf9\x10\u007f\t%ʓ\xb1Zn\xcf5\xd1\xf1H\x18I\x94\x8a\b'Af\x81_\x16`d\x1dM\xcd\xd04=\x9a\xea\x81\xe5*\x15\xe3X\xc2J@C\x92\x92\x97GS\xa2\x03\x8fa\xa1m\xe0\x95\x11P\x15\xafY\x1b#`\xdd=\xe7MN2\xb3\x8b\x8b\xf7w_\xe7\xb7\xd7\xc9\vP\x86\xb6\xe5\x9cհ\xd2\xf6\t6\xe8\"7\xe6\x8e\xe4:\xe0\xd2\xce\xd1{\xb9F^\x13\x96\x06\xb9ݢ)\xb9K\x15Ա\x821\x01\\\x867\xba\xe8\xacQ+JQ\xad\xf8yG\xfd\x0fS2\xbc\x98\xdeX\xeb$\xc6\x18\xe8\xe6\x06\xfem\x91ނ\x9a&\xa8S'\xb5\x98\xf4\xfd\xbb\x126G\tO\x1d?\x1a\x9a\xa2˽_+\x01i\x84\xaa3*g\xa3\xc2J\f#\xa1\xff\xe8\xfak]\x1d\x86\x9d3\xc7)\x1fkK\x03\x8fn\xdd\xdf\xd26lЉ4\x9b\xd4\xc2@>w\xe6^\xcb%\xb6\x0et\x99\xf5߿\x04Qŗ\xb1JY\x97\xc7q\xf9\xec\x05\x90W\xe4\xb3':\xc3\xc5O\xeb\xa0\xe3\xe8\xb0A\xe7q>\xdcMc\xfc\x04\x9erm\xb1\x8f\xc3\b\xa3(M[\xf1v\x19\u007f\xf9\xf68j\xb2}<v\x864\xd0\xe3\xb5\x05\xfbӦ\x1a\xac\xa2H`\xdfFP\xfe\xb6ކC/\x80W\xcf\x18[번\xd6\xcf6\xbc\xe8\xf0\x179d\xecq\xe7\xc3\x02\x9d\x92Z=c\xd9\xf7\n\x0eeMU\xa3'xr* \xcfO\x89\x97\x96\xe7\xafo\xdc\xf3[\xed4\xe6\xed\xbf\xceB\xd5[\x8d\x8b\xe0\x94Y\v\xc8\x05\x10\f\xeb\xa7\b\xe7\xbc:6\xc5\x18\xb8\xf8/OS=0\xe4T\x83\"\xfb\x19\x00\x00\xff\xff\xac\x16kM",
-	"x\x9c\x8cVKo\xdc6\x10>K\xbfb\xa2\x1eJ\xa2\xca\xda\xe9q\r\xb5p\x8d\x04\b\xea\xd8E\xd7@/\xbdp\xa5\x91\x966\x1f\nI\xd5v~}\xc1\x87v)\xd9q{\xb1Lj\xe6\xfbf\xbeyh\u007f\x13\xba}\x80N\x93\xb2`v\xc7\xe5(p\xe7\fW\x03l\x1b\x90\xe8\x0e\xba#eQ\xf0\x9e\xd8V\x8fXC\xb5\xf7\x1eU\rU|[Q\xd8l\xa0\"\x95\u007f03L\x12\x95\xbba\x12-\xb0qDՑj\xb3\xd9T\x14\xee5W\xc4\xfbE\aZ\x95\x05\r\xa4k\xbaO\xdaH\xe6\x1c\x1ah\x85V\b}8\x87@ɀn'\xb4#\x95E\xd1W\x94\xc2~\xeaiY\x16-\x13\xe2/\xee\x0e\x97f\xb8\xe6\xd6eh\xcc\f\xb6.\x8bb\xe5\t\x9d\xfe\x82ֲ\x01\x83\x050\x9b\x8e`1\x84O*\x8fYQ\xea\xc3,\x8bSP\xdb\x06n\xf7\xf7غ\x14^Ю\x10\\!\x00\xc0v\xdb\xc0\xb9\x17\xcc~\x94\xa3{\x0egg&,\x8b\xa2\xc3\xd1\x1d2\x93\xfd\xd4\xc3좸(\x8bB\x8fvu\xc3\xec\xa0\xf2\x1b\x8f\xac\xb8[\x16' 5\xb0ï\x13\xaa\x16c\\\xfe\xde\xe35p;\xa2aN\x9b;\xb6\x17\b:\x9d\xac7\b\xf0k\v\x83\xff\xa0\xb1xi-\x1f\xd4\xed\vs\xe6\xfe\x98\x82\x8cAO/\xfa\xdd\U000c8fb0ۦ\xa2e\x11\xe4*b\xedw\xf85\v\xd5\v\n\x1d\n\x1c\x98\xc3;M|\xed.`V\xaa\x81\x9e\t\x8b\xc1[\xe1\xe3\xb5\x17\xf4e\x9aG\\R\xfd\xad\x02]\x94\xbe\x81\xf0\xf8\t>\xf8\xab\x13fҞ\x9e@\xed\x9d\xcep\xa5\x1d|w\x14\xfe\x9f\x00q3\xc9=\x1ax\x1f\x0e\x14$W_\xd8\x139\xaf\xe1g\n\x06Gd\x8e$\xa0\x9c|\xe9=\x8b\xc0U\x87*/Vl\x81\x04\x93\xa5\xe2K\\Q\x1a\x9c\xb2v\xcf<\xf7\xe2!\xc4鉶\r\x1c\xbby/\x1e*\n2\xf6\xaew/\xce\xce\xe0\xee\xc0-<j\xf5\xa3\x83Gm\x1e`R\x8e\v\xb8\xba\xfe\f\xf8ĭ\xb3\x9bd\xc7\xfb\x986ۣ\x80wM\xb0h\xb5\x94Lu>\xc1k\u007f\x1fX\xb3P\u007f\x800\xe9'??\xcc\xdb\xd3\xddQ\x84\x1aB\x85\xe0\xec\fB^9HJ*֏\xf7d\x95\xcer\x8dX\xfe\r\xe1\x178\x0f\x91d(o:\x9d\xb6M\rU\x1d\x89\u009fT\xbc\xb7j\x97J\xf09T\x0f\xbbyOH;\xac\x11\xb2\x94h\xe4\xf0\xdbe.\xff\xab0\xaf\xf4^\xec\x8a\x06\xe23\xb5pt^s/M\xdf\aӌ\xeb\xfb\x1c2\xdc\xd9\xc1\xff\xffx\xe0\x02\x89\x8cz\xfa\x1e\xf03\xa8\xba\xdbާ\x15o\xfdu\x94\xa7\x01\t\n\x9f\x1c\xfc\x9a\x956\xdad\xe9KPLƉH:{\xca\xd97\x9e[\xad\x1cWa\x1cg\x9b\xd3H\x12I\xe7p\xd2\xf0\xd6\x10ǧ\x0em\xea\xdb\xd3\xe7\x92/\x8082\xc9+\xec\xa6\x03\xb3\xbf\xe3\xf3\x1c\r\x05w@E\"{\xd8\xf2^\x83c\xa3\xd8c\xa6\xe1]ύu\x1eኵ\a\xec\xfeD;\t\xf72\xd3̶\xcd\f\xc3\x18\xc4\xd1H[r\x8e\"\xbdI\xa2\x1c\xe9\x98#\x1fh\r\xcb2\x9fޤĊ\xff\x90:\x96\x8f\x99\xe1JOʅ)I\x90\x97s\x96D\xd2E](\xa0\xb0\xc8{\xe2\xbf\x0eo\t\xb6b\xac\xb3,f\xd6\xc8\x10\xe5X\xe7\xf2\u0080\xe6\xfc\xdf!Y\xe1\xff\x8f\xac\xe2\xdfe\xaf\xd1\xe5T\x1c\x9d^\x99\x8b\xac\x9d\xd2:\xb2\x8e\x99\xb0\xb3E\x1ar\xbf\x1f\xb2T\xb4A\xd6\x1e\b\xaf\xfd\xe5q\x86\xf8iC\xe5\xb3\x13\xc0\x16M\x1bWRj\xfc\x8b\xd4\xe3\x17\v\x8b\x8a^@\xfe\x8dI9\xbe\xbe\x95\x98\x19N\xcbmf~wd^\x11\xd1W\xb7V\xfa\x8ds?Y\xb7CÙ\xe0߰\xcbĲ\xce \x935\xc4'<\x1a\ue434\xbaCJKZ\x96Wڠ\xff\"]떉\xb8\x92\xaf>M\xaau\\\xfb\x0f\x00\xefo\xb8 \x1f\x9fZ\x1c\xfd\r\x18\xc6-\xd2\xf8\xbb\xc9=\x8fa[e\x0e%-\xff\r\x00\x00\xff\xff}\xa2\x0f\x18",
-	"x\x9cT\x8eAj\xc30\x10E\xd7\xd2)>ZI`\x8c\xa1\x85BC\v9@V\xdev3\xb5ǎ`,\xb9\x1a\x19JO_\x14\x92Evz\x9a\x0f\xef]X\x95VƜ\xbd5\xa4c\xdcv᱖\x98V\xbc\u007f`\xe3zͳ\xb7\xc6hCeY@z\xbf\x93^\x8eJ\xdf\xc2(\xbc\vM<\xf2\x8fw8}%\xd7\xc1\x9d\xe0\x825&.^\xa1\xf1\x8f\xf1\x89ס\x83\x82\u007fG\x89\x13\xfb\xa1\xc3\xcb[@\xdf\xc3\xf5}\xef:h\xb0&Xk\x8e\x14sz\xb6o\r\x1f\xad\x93\xe4\xc4\xd6\x18i\x9f\x12\xb5\xfa\xd6\xd5d\x13\x89`\xbbϨ\xac\xe7\xea\x87\xd0\x1e\xc7Ʃ*\x96\\\x98\xa6\xab\xa7\xb2v\x10оs\x9a\x1b\x85p\x93(\xd7\xf3c\xec\xe5\x96\x13\xec\u007f\x00\x00\x00\xff\xffd\xa3U\xf4",
-	"x\x9ct\x91\xbf\x8e\xf20\x10\xc4k\xfb)V\xa9l)\xc5W|ŉS\n\x8a\xbb\xea\xfeI\xe1:\x9a%ـ\x0f\xc7\x0e\xb6\x13\x89\xb7?\xd9\x01\x029(\x13\xef\xccog\xf6\xb3#\x87\xc1\xba\x15n4Am\x05gX\xd7翰(\xa0\xa5\xb0\xb3\xb50\xd8R\x0e\x9d\xa3*\a{z\xf6\x80\xe1\xab\x0fWo\xa0\x9a\x0f\xa5_\x06\xd4⟔\xcf\xe0I72Y.\xbdW[\xf3иB\xad}\x0ex3u\xeb\x9fF\x00}yl7V\x03\xfa\xef\xd5\xebӅ\xc1\x99\xa3\x81\x9c\xa7\xe5\xccb\"\xcd\xcdO\x82w\xec\xa2\x1c}\x19\x9c2\xdb+\x01gl\x13?K:\xf4d*\x82J[C\x80]G\xa6.\xe9 \":n\xa4\xdaN\xd3(\xcf![\xac'H&9cil*m@ݓ\x87ިCO\xe0\xad\v\xd0XGX\xedDꗳȝ(\xd9\xda\x00d\xa7\x82/k\xa2V[\xf3FM\x10\xffe\x0e3\x84'MU\x10\xfb\x1c\x86\x1c\x06(\x8a$\x96\xb0\xa7\xa3\x1f\x89?V\x19\x91A&を\xff!\xae\xcdX$܉2/rr=\xe7H\x17\x8b9ƣ-\n\xb8+\xc4\xf1\xb6\xf2Q\xe2\xf88\v\x9a\f/;s&\xb9\xe4\xbf\x01\x00\x00\xff\xff֯\xebP",
-	"x\x9c\x8c\x92Ao\xd40\x10\x85\xef\xf9\x15#\x9fl\x91J\xc0\x91\xaa'\x04\x82C)b\x0f\\\xb8x\x93Iv\x8836\xf6\xa4l\xfe=\xb2\xd3d\x1b\xaa\x95\xeaK\x94y\xef}/\x8a\xe70'\xc1\x11Z\xaf+\x00\x00\x8c\xd1\xc7o\xd3x\xc4\b\x1f\xee\xe0mU\xa6\xa3=\xff\xc0fn\x1c\xb6\x0f\xc7\xdf\xd8HZĬŝ\xf0\xd1O,\x171\xa1\xdc_\xc9.\xfa<\x1e\xbd+\x13GI\x96a\x8f\xf2\x10\x84<\x97\xf9\x88r\U000addb1Ou\x91\xf3\xf1a\xc1\xdc\xdb\x00\x8d\xf3\x8c\x9b2\xf1\x803\xb6\x1bq\x9d\xe7<t>\xa2mN\x9a\xea\xfc~\xc1=\x19\xe0\x88=q\xfaIr:\xe0\x1f\xadnn\x94\x01\xea>[\x97P\xaf`\x1b\x02r\xf9\x1es\v\x8dg!\x9e\xd0\xecPCnϼ\x8e\xb8-\xa4;\xb5wP\xa7\x87}}>lG\\\xa3x>8jP\xbf\xafa0/\x9c\x8f\xd6\xfdo\x1c\xe0\r\xbc\xdb;_\xd9p\x15\xaf\xd4N\xd9\xfb\xca\x15X\xf9>\x89\xce\xd4:\x87.\x0e\xf3\xe2B(}\x1a\x83\xcc\xdb\xff|\x96W\xaa^m\xc6\xec\xee\xb8ZX\xe51%\x8c_Y0\xc6)\xc8\x17˭[v\xf4iA\xb6\x9c\xfa\xc5\x11\x1b\xa4\xc7\\\xba\xfao\x01\xcf$Ľ\x82\x10\x89\xc5\xf1\xe6O躢>/K\x0e1d\xfa\xb2\xb3y'\x0f\u038bV\u007f-\x892\x95\xa9\xfe\x05\x00\x00\xff\xff:\x06ܿ",
-	"x\x9c\x94\x90A\xaf\x820\x10\x84\xef\xfd\x15\x1bNp\xe2\xfe\x92wy/܌\x1a\xffA\x85\x95T\xebn\xd3n\x0f\xfe{CQ\x100F\xaeۙo\xa6\xb3e\u007f\xd5\x16~~aw<c-P[&\x84\x86s\x05\x00`\xc2?\x93\x18\x8a\xd8IN\xda\x06|\xdc\xff<\xea\xcb\xf4\x18\x84݆\xd9\x19j\xe7\xea\x03J\xf44\xbd\xca\xcd%j\xd6w\xc8T\xa1T\xc5\xcb.\xda9\xa4f\xefY8\xef\xa5E\xd7\x0f\xca\x12\"ŀ\r\x18\x02\xc3VS;\xe5V\xdcC_\xbf\xf0\r\xf9\xcd\xcf\xc5\xc7Y\xe9\xe7kJ\x18\xc6X\x81\x1f<\x03{\xb6\xdf23YR\xe0\xb8\xe7\x8a\xc4\xd1\xf41\xb2P\xf7\x00\x00\x00\xff\xff\xe4\x0e\xa5\x04",
+	"x\x9c\xecY\xcdn\xdc8\x12>\xab\x9f\xa2\xa09\x8c\x84\xd58\xf1\x1c\xf6\xe0\xa0\xd7H\x9c\f\x10L~\x8cqvs\t\xb0\xa0\xa5\xea\x16\xa7)R!\xa9v\x9c\xc1\xbc\xfb\xa2\x8a\xa4ZR\xb7\xb3\xce\xeee\x0f{\xb1%\xb1\xfeY\xf5\xb1\x8a\xfd\xfe\xf6w\xac=4\xa6Xe\xbd\x95\xda+\r\x17k\xe8з\xa6)\xb6\xe8o\x94\xf1E\xeePm\xf2\x12\x98\xe2\x19\xe4\x9ft\x1e\x9e\xcbUvg\xa5\xc7\tO-\x94\x02\xdc\v\xf5\xdcn\x1dl\x8cEQ\xb7E\xa0~\x06\v\x89\x89_\xe9GK8(?%\xed\xc9\x13\xf8\xbbCpA\r4\xd2b\xed\xd5=x\x03\xb5\xb4\xf5\xd0\xedQ{0=Z\xe1\x8d\x05\xd7\x0e\x9b\x8d\x92z{\xb6\xca\"O\x91\v\xdd\xe4U2f_\xc1\x1e\xa4\xfb`\a,\xcb\t\xd1O\v\x12\x8d[\xe1\xe7$gg3\x9a\x85\xb1 ܍\xb7Ro\xe1\xec\f\xf6\xe3[Y\xaeV\x99\xd4\xd2S@F\x16\xdfJwe\xb4\xc7/>'\x02\xa1kt\xdeX7\t\x9b\xa8VY&7\xe1??@m\xb4\x17R\xbb\xd7\rj/k\xa1>\x98垖\x15X\xf4\x83\xd5 \xcaU\x961\xaf\x805\xbc\x91\xceC\xad\x8c\xc6U\x96ъ\x00\xd1\xf7\xa8\x8f\x92\x82\xd6\x16\x9f\xa0\xb7ƛ\xc3֍\xd6\x16\x82\x02\x94\x89UFN0\xd5ā\x87\xa4H\xeb|\xb9\xcaZ\xe1\xae\x13\xc7H*ݯR7\xef7\x1c\x95V8rm\"\xd2)\xe3\x8f#\xdf\n\xf7\xc6\xd4B\x111\x93\x94`\xec\x11U\xb2\xfa\xa3\xf4\xed\x84R\xbawR\x816\xfe\xb0\xd5D\xf1ᾟV\x01I\xad`/ԀՉ\x00\xa5\x1c\x99\x90Q`\xf8\x01|\x14E\x8b\x14\xa9L\xe3\xddI\xbf\x1e)~\\e\xfa\xd3;\x968r\x87>\xa7\x8c$\xe5 ܕ\xe8\xa5\x17J~ņ\xfc\xc8\x1a\x13\x92\xb6ȣ%\a\xe2\xb33\xc8a\r\xd3\xea}\ue2e7\xe53 \xabʅ\xdeh\v\xfb\xa7\xc4\xd7\xfb\x85\x83!\x95Y\x96\xb0\xdb+3h\x0f\xeb5\x9c\xb3\x11\xdd4\x12\xa86\xa3\xf5Lߡsb\x8b\xa0E\x87\x15h\xa9Xq&\b\x8e\xa6\xa9\xd3\xe5\xe5\x81\x16\xbfx\x10v;t\xa8\xbd\x1bɅ\xbf\x1e|q^A2\x84\x1d\ne\xc1B\x1f\x16\xe6\xd0?O\xf2\n\x12vL\x9f\xaa\x8b}\"k\xe1\xe2D\xf8F\x87\U000d9adf\xf2\x1f\xfe \x9e??\xe5\x15\xfc\xf0\xc7\xc4\xc0\xf3r\x04\x93?\xcb\x1c\xa4\xf6h{\xa3\x84G\x10\xeem\xb0\x91\x84\xceđ\xa8\x11\xac8\x1d\"eх\xf0i\xa9\x02\x10P\xa1I\xf7\xbc\xf6r/\xbc\xb8U\x9c\xaa\x1b\xa1\x1cr=\xbc^.=\f\x80GR\xf61U\b\xe0\xdc\vc\x14\n>\x17\xbc\x1d\x90\xbe5\xcd\a+\xe44M\xf6\x84^.\x02\xdfQ\xb6<\xad\xe0\u0557\x1a{/\x8d\x06+\xa4\xc3\"\x8f\a\xde(\xcb\xe2\xe7AZt`4\x12\n\xf8;sH\x84\x90\xb3\xb4z\xb1&E 7\xef\xa4z\xb5\x17\xaax+\xfaC\x1e\x8c\x9e\xed\xf32\xa1\x1e\x87uW\xc1>\xc1\xf17QhW2\x19\xd1\xed(\xd1s\x02\x81\xbcb\x04\x97z`-\xc1\x12\xe1\x8b]\x19\f)\x8e\xbc#Y\xc0%\xb9\xa3?9\beQ4\xf7\x80_\xa4K\xfed\xd9Ҕ\x94\x05\xa3\xfc)hD\xa6ꛜ\xbb\x13\x1ce<9\x16<!\x85\b_^\xa2\xab\xadd\a(\x9c\x87m]e\xbc>\xafפQ\x19\xffNt\xe8\xc0\x19\xeb_\xebk%j\xaa\xa2\x06]\xed\x12l:\xe8D\xbf\x84\xbf( \xbd\xd3*\x97\x8a\xecz\x85\xf1\xf4]eٸ\xb3 \x9a\xe6W\xbcw\xcfu\xf3\x0fB+\xc7\x02]\x05\xac\x8a\xfd\xc8DoMo\xa6\xc7\xf0\xee\x8e\"ue,\xce2\x81X+\xe8i\xed`\xeahZ\x1f\x1d[D\xe4ҡ\xc2:\xa6\x11\xec\xa8~\x87P-\xcaܡ\xad\x85\xc3\xf1\x8c\xbf\xc1\xcf\xc5\xee.\xec\x95\xdc\x14A\x87\xd0M\xb0\x16\x9c\xfc\x8a\xf07x\xca\x06dl\xf0\r~\x1eP\xd78\x02Q\xb2l\x87\xf7\xb3\xe8&G\x8a]\xe0\xce\\\xec\x05Hg\x0e\x90\xb3mJn\xf5\x1b\xdc\xf8\xe2\xfc\xafe\xc5GA\x1eC\x95r\x8aZ7\xb6o\x92\x13\xe4yc\xea\xf7\x9b\x98\xd5F\xa7\n[(\x01`E'\x19\xa3\xe8 ;\xfc\xa5xC\xech\xa3˓\xb7\x94\x9a\x8cjt\x90+\xe3o\x86\xae\x13\xf6\xfeh+O\x94n\xea:\x8a\x17\xcaԻC\v\xb5\xa4;N\xae\x13\x81\x9f\xb9\x99W\v\xa6\n\xf2\x8b\x18\xb6Y\x86\xcf3e\x95e\xbb\xbb1|q\xf3\xd7i\xeb\xbf?\x89\xca\xd5#s\xe1\xbf̈́e\xc4\xfe\xa3TX\xf0N\xb2\x81\xfcp\xa1PgQ\x9dl\xf2R\n\x01/c\xe7?\x19G\x97˃\x96\x9f\a|\xdd0\x86\xa5\xb5\xcbC\xa3?\x1eד\x13\xf9)\x99\x91=y\x02\xaf\xf6\xa8\xc1\xb7fضp\tҁo\x11:\xe3<\xdcJݐ]i2\xa9N\xcc(QH\xdd\n\xbdE\a\x97EW\xd2\xe9nಠS\x9af\x1co\xef\xe9_oѡ\xdd#\xf4\x16kl\xb8\xc8\xcd&\xf2\xf7¢\xf6-:tgp\xd5b\xbd\xa3=\x05\xdfJ\a\x8c(\x04\x1a\x93aIz(\x84\x8aV\xfb\x16\xa3\x18c\xe5Vj\xa1\xa01\xe8\xf4\x8f\xbe<\x8b\xc8\xd3q\xe7\x05ҽ\xeaz\x7f\xcfҺ\xd0\x13\x85\xd69|8\xee\xea\xb2\x0e\xd6\xd0\xcdBV\x8e}Jچ |\x91\x1e\x1d4济\xf3\x11w\x84\xb1cs\xa8\x1b\xb4!\x13\xa8\xa7\xb9\x16\xce\xddxӻ\x82Z\x8a\xd8̸\xa1G;I\bJ\x99z\xdc\xc1 \">+㣖\x87\x0e\xe0\xd8^\x04\x99Ą\r\x98\xc1;\xd9 \b\xb8%\xb8\b\xa7\xe3q\x8e<J\xe4خ\b=\xb6)A`j C\x84hʢ7W\x1f\xcf1c\x04Su=@Cu\xbb1\xf6N\xd8&\xa8\xe0\x99RΦIW\x97'\x9a\xac\xe0\xbe\x1b\x9b\x11\xd2H\xffs\xd0\xc6\xc3\xc6\f:J\xbc%\x1bŸ\xbbD\x17\x15\xa5O\xf9\xedQ\xa7\xb8\x8e\xfdf\x05\xb7\x13\x10 \xba\x1e\xed\xc6\xd8\xee\xbd.\x8e\xf6\xce\v\xbbE?ˋ\n\xba\n\x04\xd9Q\xae\xa8\xb5B\xdd\xfc?\r\xfe\x97\xd2`\xb6\xb7\xb3\x8a\x7fĮ\x8f$\x84\ayY\x9d\xd8ǣ,\xa0\x9b\x97I\n\xa8\n\xd4x42\xae\xf0팻\x93\xben\xe7\xa9\xc2\xe5\xbc1\xb6 \x04\xad\xe0i\x05\xf3i\xe4'\xf8\xb9\x82\x9f\xab\x88\x9d\x1f\x11j\xa1\x7f\xf4\xa9oh\xd1\"\xdcb-\x06\x87\x01\x88\x83d\xe8\x85s\xd4\xe9\x12f%|]L\x88\xa4\xb0$\x14%\xfb*\xb8\xb5(v\xe3\xe0\x1c\xd7\xe1/p^\x8e\ab\xc0h\xe3\xd3`2\xb7T\xba\xf7M\xc3Kّ\xa6\xb9G瓹\x80\x1b\xa9 >\xde\x13\x1c\xe6\xc787\x9e\x02`\x1a%߈A\xd7\xed\rO\x02\x93\xa0\xcefy%nQ\x91\x937\xf7\xcec\aj\xc2CR,*\xe1\xe5\x1e_\x9a_d\x18;\x1b\xf3[\xfc\x96\xbeD\xb9܂S\xac\xa0a\xe2\xe2Z\xf8\x16\xee\xa4oO\xa9\xec\x85o\xafL\xd7\x1b\x8d\xdaW\xd0G\xb4\b\xfa\x97\x92\x85o+\n\x10\x0f\xa4\xf4?\xd62?\xaeAI\xbe\xc7ʢ\x0f\x8d)\x82\x186\xe0bͺ\xe6\n\x9f\xcd\x1cM4$\xe3%_l\x1a{OQ\xbd\x1a,\x1d\xe9\x1f\x8d\xddI\xbd\x1d\x97\x8aY\xb0\xae#\xe7\xd4\xf5(\x8d\"(\xb5\x92\x1a\xdfr\x8cN\xa4\xf6,4#T\xa5\xc1\xa1\x83\xe5\xe4\x9f\x0e\xd8C\rwi\xf2k\x90\xba\x12\xe1\xb1\xf9(\xac\x9e7cB\xf9j\xa6rZ\xaf\x0f\x00㱼n\xa0\x8e\n\x13\xf0n\xac\xe9x\x83\xa5\x06\x01/\"\xf8&oRH\xe2=t\x91')RS\x93I\xb9v\x8b\x8a\xda\xf0\xf0J\xe0T\x01\x1d\x85\xcaӘ#\x1d\x1c,8\xe3\xdbgn\x1c\x85\x8aWbR;\x8f\xa2\xa1\xcb\xe0|N\x9c'\xd4qhe\xb8d\x9b\xc4\xc2y\x8b\xa2\x8b\x13Hx\t\x1dT\x05\xf1\x8dG\x89\xc0)\bwo\xc2\xe7\xf1Z\xe2\xf7\xc1\xf9D\x80I \xe7@ \xbc4\x83\xef\aO۲\xa0=iF\xd4ʁ:\x1a\x89\xb6\xe8\x0fw\xaa\xe1\xf6\xe2!̎\x1c|\xbd˗\x9d\xd3ShI\xc5\xeb\x14g\xf6\x8a~\xa8\x88\xa3Ò\xf0\x10C\xd2⎽\rv\xe7e\xe0/\xa7Qg\x8e\x93>\xff\x1b-\xd4/\xf1eD\xf1\xe05Eڭ\x93:G\xfe\x89r=\xe5\xaa\xe2\x91~\xb8L\xa77\xfa\xbap\xeb\x93\xcf+\xee\xfc(Vt[xr\xbcJ\xefD8uf\x12\xad\xa5\\\x8e\x16\x99^\xae\xcaՊʚ6a\x95\xc9\xcd\az\xbeXC\xecLF]\xe9\xc4\xd0\xcdo|\xbc\xdd\xc4k\x1fߢ~\f\xfd;\xa9\xf2Gd$\xcc\r%˨\xa0\xcaՊ\xbb\xc3d\xe5/\xfc\xf2\x1df\xe2#飙D.7\xa7\x18$\x8bK7d\xb9\xb1y\x05\x0f\xfc\xac4\xbb\ue317\xa9\xdf\xe9?s\xc5\x00h\xa9\x92\xfb4q}\x87\xf3c!~\x83)\xd2\xf1xNd|\xf6\xa7\x0f\x89\x97?>\xca\xf7Z\xc4n\x8aY\xa8\xe3\x19_fa\xe1/\xdf\x19\x14M{\xc4!9\x05\x94\a\x17Gp9\xfc\xf6\x16\xe5\xa6\xe3!@\xe5\xa9k\xb3G\xff\x06\xba\xaf`*\xeap\xa5\xb1/\xcbrU\xae\xfe5\x00\x88Vb\x86",
+	"x\x9c\x94S\xc1\x8a\xdb0\x10=K_1\xf8$AX\x16\n=,lK\b=\x14\xbaf!\xfd\x81\xd9x\xe2\x18F\x92ь\x97\xee\xdf\x17\xd9i\xa2\xa4\xc9aO\x89\xc6o\xde{z~\xde 3t\xc9Y\x83\xb9ߤ)*<=C =\xa4\xce\t\xf1\x1e\x02\x89`O\xf0﹟\xa1\xeb\x1a\x17Wp\r]\xab\x8b\xde[C\xef\xc8\xeb;p\xa1\xd8Q\x86.\xbd,{\xff\xe9-$ޚ\x03\xca:\xf7Rq\x9c\xdc~\x83ǳL\x8d\xb8&\x93\x1f\xef\xc8\x13*u?\xa3\xab\xf4\xbd\a!}E\x91\xad\xa6Q\x9c扼\xb5\xa6#\xd9\xe5a\xd4!Ŋ\xd5\x1a\x13ʱf\xb7\xc6\xc8i\xa6\x98{RЏ\x91\xe0\xe1\x01\x1ah\xcaO\x80\x88a\x01\"\x0f}\xfcE{u_\xbe\xfa\v\b\xe3\x1b1|w\x8c\xa2\xaf\xa8\x87M\nc\x8a\x14\xf5\n6Dj\xa7\xf0Fٚ\xc5(S\x8fJ\xbfS\xe5s\xb1\xb1\x82(+k\xcc\xd1ԝ\xa4w\x9c\"\x95\x10Z\xfa3\xaf\xc0\xb0o\a.y]\x06e\xcdͬ*\a/s\xf6\xb7|`\xa0O:)R-\x06r%:\x7fљ{N\xac\xc9$\xa4e\xb4U\xd4\xe9\xb2\x0f\xd5ܵ)\a\xe4r\xa5L\x8c\x1f77\xca\xeb\xd24\x96\x89\x9c7s9\xef\xcags\xea\xb6{,Df\x1e\x1eK}\xfc_K\x16\x8e\x02\xebI\xb7\x9c\xd45\xb9)\xf7\xb0\xd6\xcceyz\x86f\x83̍\xf5\xf6\xef\x00\xa3\x11,u",
+	"x\x9c\x8cXAw\xdb6\x12>\x93\xbfb\x96'2f\x12Iv\\w\xfb\xb4\xef9\x8e\xb3\xf1\xae\xe3z+\xb7i\xfb|\x81đ\x05\x8b\x04h\x00\x94d\x1f\xf6\xb7\xef\x1b\x10\x84@I\xe9\xfa\x02\x81\xc0|\x1f\x063\x83\x99\xb1'\xf8Ԡ\x98!\x142\x8d#\xa6'\xbc\xaaK\x9c\x18\xc5\xc5\x03\xfc}\f\x15\x9a\x85,\xd2\xe4>I\xe0\xdd;\xd0X\u0381i\xb7\xcf\xf4\xd7ưi\x89\x80z\xc6j$\t\x92\xcc\xe28\xd2h&\xa54i\xf2\xee]\x92w4\xab|\x87aVJ\x81\xe7u\x8d\xa2\x98\xe0S\xba\xf2;Y\x96\x05\x1coz\x14^g\x8b\x86\x99\xac\x9fS\xa2\xcd\xe0\xcd\x18V}\u0ad1o\xc6\xe9\xaa\x7f\xe8\xd1+\xa1G\xbb\x87\xbe}%\xf0\xed.\xf0\xfd+\x81\xef\x1dPIi\xbe\"\x13\x93\xa7\x86)\f\xdcUm\x17\xf5\x932\xe4\x0f\xae/\xab\xda<\aBtq\xd0\xfc\x05a<\x86AF\"\x93\xe7j*\xcb]\x19\xee\xdd,d\xcbe\xb0\xba\x90uH\xf6]eI\x9e\x95\xfcA\\\xe3\xdc\\\x89ے\xcdBM\xd79Ԭ\xc8\xe3(\x92\x9a\x96I\x9f8\x8a\xf8<\xadY\x01\\\xdf\xf0\x12\xa4\"\x99\xad\xaa\x16\x02cH \xc9\xe2(J\xd35\xbc\xb5\xbb\x19\xbc\xf7\x92\x19̐\x97\xa0\xb0FfR\xe6C\xacf\x05y\xd9\x1a\x9d\xbf`\xba\x86\x8amR\xa9i1\vT\xdd\xd71\b\xf7\xdd\v9\x91\xac#\xf8\x85?,\x0e1\xecXi\x9f\xc6]Ċg\xb0\xd5ڙ\xb2U\xef\x02\x85AuHA\x7fv\x9a\xa6d\x108\x82u\xf6~\x94\xc1\xbc\x94Ru\xac\xdfU\x9e\x12\xc0\x05\xab\xb9a%\x7f\xc1\"8\x81\xcfS\x1f\x059̼Lh\x94\xed*\xbdb\x1bIY\xb6C\xf9M\xaaB\a\xbc\x87Э\x8c\xa7 \x86k\xb9F5c\x1a\x0fBK\xbf\xdb\x03\xfdZ\xd7\x7f\x01j\xeaz\x0f\x14G3)\f\xe3B\x9f\x8b\xe7\v\xa6q\x82O\x01X\xfb\xec\xb5է\x03X'\x85;tu\xae/\x9f\x1aV:\xb2>\x13\x9f\xf7\x1f\xa0v\x8e\xdf\xd7 Ք\x97\xe2\xa8\xe4\x02o\x15\x17a`}\xe6%\x826L\x14L\x15?7\xa6n\f\xac\x157h\xc9sH\xeeE\x92\xfdd\xd5&\n\xa6-`\a\xbf\xe6f\xe1#l\xceK\xbcaU(\x14G>{\xf0\xf9\x9dj0Uh\x1a%\x1cm\x14\xd5$\\2mn\x99Y\\Ȫ\x96\x02\x85\x01]\x97\x9c\n\x80}\xa5\xf4\xa2\xed\x15\xe1\x1f0̡\x06\x85\x95\\\xe15ӆ\xb6kx\x94\\8aʉ\xb6\n||\xbe\xdc\xd4L\x14\\<\xdc\xf1\xb2\b\x95r\xe4\xffM\xb2\x16ih\xbf\x95\xd6w\xd2\x1a\x8c\xd33\xa9e\xc9\f\xbaP\x0f\xf1d|\x9f\xa6 \x90%\xcfUl\x89\x9f\xb9\xd2\xe6b\xc1\x14\x9b\x19T[\x97o9ȇ\xed\x8d\x0690sۘ\xd4N\xd2A\xd6\x0f\x85\x83\x8c\x87\xe2\xf3\xaf\x19=\xc22֔\xdblz\b\b\xc8%\xc0̕Ш\f\x05倞kY\x02\xaeXy\xae\x1e\xb4\xb5\x96\x0f\x89HaM\x99\xe7+\xab\x03\x92*\x87\n\xe6R!\x9b-\xd2e\x0e]\xd9v\xc2DK\xab]\xb9\xbc2X\xe9;y-{-\xc3Ɓ\xb6\x02\x9fd3-1݀\x92\x8d(>ɵp9\xe7\vn\x02 %g\xfa\xec'KZ%\x1d:\xb5T\x0e:H\x90\n\x98\xfe\x82\x1b\x97\xc5\xe7\\\x147f\xb1\xf7|\x05\x15\x9a%\x91\x93\x04\xddCS\xf0-\x81\xcfoxم\xb5ोXA/s\x98\x83\xdbX\xd2\xf2\x12\x8eڋ\xe1fR\xf2\x19\xa6\xb40\xec.k+\xcf\xf6xz\xe9\x02\xde°\xd5K\xaa\x02\x15\x16\x13\x8aݝ\x1bc\xadi\xa5\xe7\xabV\t\xbbե\x89\x81W\xa6\xe4\xda\xf8W\x1bq\x02\x0f\xe2(\xdat\x13[ \xacP\xd6\xf1w\xb6\xd3X\x93!\xa2Ǟ%\xb0\u0381g\xce\x14\xb4\x1dm`\f\x1b\xba\x9d\xfd\xa2\xcc\xc4E\x83\xf4A\x94\x91r\xf6O;K\xf0\x1c\x1e\xc9\x03V\xedM\x1bě\xae\x04{i2o\xf6\x13\x10\xf9\xa0\x15\x861<Z\xab\xd6]\xfdw\xf6\x0fo\x1d\xc2s\xd8?\xdbFc\xf4\x9d\xe3\xc8\x1e\xd6\x03\nW\xa8zOΥ\xf4\xae.8\x01\x970(\xc0\xf9\v^\x89\x8f\xcf\x06\xc3\xc2e5{\x03\xdc`5!\x9fǑ&5\xf4G4kD\x11J\x1a\xa6L\x0e(l\x97S\xf6\xbc\xb2융^\xf0\x12SF\x9fV\x1f\xef\x94\x16\xbc\xcc\b\x1bM\xf7\xf6\x89\x15(\x00\xad\xa0\xb5\x1e\xf1FS\xe7ƩBf\xa36*;{\xf4b\x97\xf5\xa09L\xad\x11\xa3%\x8ca\nG\xa4t\xe8\x91Қ\xd0f\xde\x1bI\xed$\xefۄ\x98kTs\xa9\xaao\xdc,\xce\xd5\xc35\xdd4\xb1\x88d'\x11e\xf4bp\xe6\xbb\x10:\a\xfeF1\x91\xc5\x11\x95\xa4\xff\x9b\x12\x0e\x9e\xd5\xder\xef\xb0\xee)\x85\xedl\x0eT\xd1\xc3N\x83ґvgL\xd0\xd83)5\xb6\xc7\xd9?\x91\xa26\xcf'\xbe\xe9\xe8tJrH\xd8tV\xe0\xfca\xc1\x1f\x97e%d\xfd\xa4\xb4iV\xeb\xcd\xf3KB\x05\x81\xb7\xb5\xce1\xf8\x0e$d8\xffx\xf1\xe9\xf2\xf3?\xbf\\\xfd\xeb\xdf\xd7_o~\xbe\xfd\xcf/\x93\xbb_\x7f\xfb\xf6\xfb\x1f\x7f\x1e`(\xf8\x037!z0\x1c\x1d\x9f|8\xfd\xe1\xec\xc7@\x9a̹\xe0\x06'5\xe5n[W\xb5\x8f\xc2\xe4\xdeб\xf7\u008e+;\xce\xed\xa8h\x04\x1a\xee7g\x1f\xda_6H(\x10\x93\xfbfxz6\xb0k\xcdh0\xd8Ά~6\xf2\xb3c?;\xf1\xb3\x0f~v\xda1\x8e\x06\x83\x1f\xfcꙟ\xfd\xe8g\xac\x9b\x8d\xfc\xee\xc8\xef\x8e\xe6\xdd\xee\a7;&\xbdȥъ\x95\xbc\xb8\x143YtWO\x1a3?\x83\xc6̇\xa74\x1e\x8f\xa0d\x86\x8b!\x88\xa6\x9a\"Ւ\x19\xe7I\xdb\xc08<\x15\xb9\xbb\xe7\x1a\x1d\x9e\vs\x06\r\x17\x86\x18\xb80\xc7#\xfbszB\x9d\xc4\x19\r\xc3S\x1a\x8fG4\x9e\x9eP\a\xceH\xca\xfe\x9e\x9et\xe4q\x14\x96>[\xe8\xb38\n\xff\xb2b&\x1dҫ|鯍\\\xf1\xfd=X^\x05=\xc3j[\xe35\x9a?\x0eI\rw\xa5\xfe<$5ڕ\n\x84zo̗fn;\x86\x16\xcd]\x9f\x10G\x8f\x8d6\x13T|\xefo\vm\x14\xb2*\x87\xf67h_\xa1\xff\xef\x88,\x8b\xb38\xfe\rgF\xaa\x03\xc9\xc0\xf5\x19\xe4\xa34q\xd6N(ɘ\xce\xf5iҺ7\xc9\xe2\x95gqJ\xc4ъ8\x1d\xbb\xeb8\x0e_o\x93êK\xa6\x9b,\x8b\xa3U\x9c\xc5\xff\x1b\x00\xbaWj\x0e",
+	"x\x9clR͊\xdb0\x10>KO1\xf8$AX\x9a\x1e]\\X\x96=\x94Ҧ$O\xa0\xd8cEE\x96ҙQ\xb7\x8f_d{\x93\xecfO\x86o\xe6\xfb\x99O~\xfe\xd7\xe3YBN0d\xa3U\xef\x8a?\xc9\x0fdv\x1e\xa1m;H!j\xd5g\xcaEB\xba\x81\x12\xb2\xe0p\xa5_\x06\x99\x82\x0f\xc9\xc5'\x17\xe3\x05\xad\xcaҟ\xa0\xed`B9\xe5\xc1\x9c)K\xde@\x18\rc\x1c!\xf0\xf7\x90\x86ݸ\xe0v\x03}\xa5\xe3_\x17\x1f\xc9?\x8a\xd9\xda/Uh\x03u\xdbZm\xb5~&\xcaT\x15w\xc7\xdf\xd8\v\xf41'\\\xce\b\xe3e\xb8\xdai\xa5\xc2hfQG\xfe)\x97$\xd0u\xb0\xddh\xa5\xd4;\xafOV+e\x01#\xe3\x1d\xe7\xeb-\x851\rH\xc0(\x87\x98eٜ\xd6\xee\xdc*\x05\xc9M\xb8\xc6\xfe\xc0k{\xf52u|-\x94\\`4\xcd\xeb)\x84\x7fJ d\xd8B&\xf8\f\x8e|\x990\t7\xb3\x82V\xaaZhek\x9c_\x8e\xf9 \xf9\xccF\xa8\xa0\xd5Z\x11J\xa1\xf4\xed\xae\x97\xdb;\x96\x9d%\xeaG*j\x8et\xaf\xf1>\xf3Z\x81\xad\xbe/An_}b_\xebC\x9a\xf9\xd5iy\xb6\x15{-\xaf\x12د`̽\x9b\xffж\x837\x15Gw\xc4\b\x0f\x0fдM\xfd\xbc\x1d\x86\x84?\xcbtDZd\xb4\xb2K\x9a\xc3)\xbf\xdc'2\xcd\xf3~\xbf۷0+M\xec-\x9c)$\x89I\xab\x99V\x17m\x15\xd1*\xf0\xa5\x01\xa1\x82\xda\xea\xff\x03\x00\xab!\a?",
+	"x\x9c|\x91?O#1\x10\xc5\xeb\xf1\xa7x\xa5}\xe7\xe8n\xaf8!\xa4\x14\xa4\x82\x06\x8a-\xa8\x9d\xcd,\x19v\xd7\x0e\xf6l |z\xb4\t\x7fV\b\xa5\xb24\xf3\xf3\xefYϷ\xe3\xb0\xe6\x8cM\xb2\x86\x9a4\xac%\x06\x95\x14\v.\x97\x18X\xb7ic;o\x88\xe24(ܷ\xf8\x8d\xca\x10uX\xa2\xc3 \xd1\x1e\x87\vt\xce\x10\xed'jZ\xb7)[\xf1\xa8<:\x8f=\x96\xd8\xe3\x17l\xc4\x02\xe2\xf0\ar\x84\r9C;\xceè?\x87~\xd7\xfd\xf5\xe8\x16\xd5L\xf8\x9e-\xee\xd3g(\x94k~\x99\x994\xadB\xe1\xfbm\xeayuP.\xb6\xfa?ᡬ$\x86|8G\xfe;\x81w\x8d\x86\xfe\x1cw\xe1\x9c1$\xe5&^\xd5\xfc4rl\xb8f\x9d\xdd0D\x1f\v\x94/\xa2\xa0M\x99C\xb3\xb5\x85\xd5CZ{\xecS\x9dGf\x1ds\x84摧GP\x1b\xfa\xc2Sa\x86\x1eǢ5g\t\xbd\xbc\xf2f\x96R4s\x18<N'\x9e\xb3(\x9f\xbe'\x94Z\x86]ϵf\x89\x0f\xce\x19g\xde\x06\x00)\x82\x96\xc4",
+	"x\x9c\xecZ_oܸ\x11\x7f\xa6>\xc5@\xf7\"\xe2\x14w\xed\vP \xf5\x06p\x82;\xe0zI.\xb8-ڇ\xa2\x0f\xb44\xbbˬ\xfe\x99\xa4\x94\xdd\x1c\xfa\u074b\xa1(\x89\xd2jm'\xb1\x83$(\x10\xc4+rf8\xff~\xc3\x11\xa9WR\x1bH\xcb(`k\xa9\xb4\x81gK\xc8\xd1l\xcb4\x12&Zp\x1e0\x8dIY\xa4\xe3\x89s\x9a0[\xa9&\xe3\x174\x9e\x89\xa9\x1c-? <\x01˥p4\xab3\x99`dg\xa4\xfe9\xaf\xcc\xc1\x9f$\xbe\xe5\x12\x16v\xf6Mif\t\x9e\xd3|\xc0\x92\xb2\xf2\xa7\xb2\x18\xd0R\x8b\xaa\xc2\"]\xe1M\x94\xd9U\f\xe6/Ǥ\xd6\aIV\x16\b$$Ҙ\xad\x89T\x97\xca\xdcE\x06D\xf4k\xf16\x13\tZ\xeb\x1aT\x1a\xef\xe4rt=c\xc0\xeaB\xde\xd4>c\xc0XF\x8f\x83\x80\x80\xb1u\xa9P$ۨ\x89!s\x96\xfd\xba\xbe\xba\xd6X\x98\xa8!\x9dy'\xe9eY\x17\xbe\xf6N~.*\xcb,\xb5\xa1\xbf\x1a3LL\xb4\\\x824\x98s \x87rN\ue505!K\x12_FF\xab:\x96&\x86\xa4,\x8c\x90\x85\xa6\x95\x89EaZ'\x13\x13\x84\xda$4\x92\x88,\x03\xa16V\xad\x801\xb9\x8e\xec\x14E7\x86\x9f\xf7\tVF\x96\x05(!5F\xa15\xda\xc9\xcbkm\xe0\x1a\xad\bL\xe1\xbd4[8\aS\xc2S\x12X\xe7X\x18\x1dr>\x16z\x01\xa5\x82\xee\xe1i\x1c0\xe7M\n\x1a=\x88dPKc\x91\xa2\x82\xb4|\x8dZ\x8b\rF\x9d\xb2W\xa6Uҥ.\xf3ĸ\xb4\xf5DY\xbc\x04\x8c\xf1\xc0\xd3\xe4r\t\x17\x96K\xa8\x8d&F\xeb\xf8Bf$\x8e\xe5\xbeg\xae\x88\x1f\n\x91#MeЅz\x1f\x03\xad\xb0\xb4\xffW\xa8֥\xca\xff%\xcd\xf6Jm\xc8KQ\x1e\x13\xb7\x06a\xde\xd6&Zİ烲\"Iވ\x1cO\xaf\xb3\x9f\x99>\x1f\xa6\xa7\x1a^\x90d\x96\x98\xbdM\x8b߯\xdfa\xd2ew\xa5\x90\xd2\xf1\xad*M\x19y~\xb5\x1cr\xed\x0f\xc1V\xe8We\"\xb2UV\x9a(\xa4\x98\x84\xdc\xeaۊ\xd6h\xbc\x99x\x14%\x1a\xb2\"\xf9\xd4M\xc7\xfc\xcez\xeb@~<mm'\x7f\xd9)r\xef\x12,\xc1\x90\ty\xbf\x14\xfd\x10I\x12\xb0\x01\xe5\x7f<x\xbe\xfbb\xbf\u07b4_\xc4\xf0d\x94\xfa\x14\x13\x10\xc6\xd66\xe8\x8b\xfd\x03b\xc1y\xe6\x97\xffC\xe2>\x908\xf2\xd6\x17C\x06u\v\xb4;\xb8}m\f\x8d\x8fv\xd1}=to\a\xf1\xcf\x04\xe8\xd88\x857\xb5T\xa8\xdb\xcd\xe8\xa7)*mk\xb4\x18K>\xe7`\xb6X@4\x9f\xf14j\xe1CN\x11&\xdaY\xb8X\x01&\xda\xc1\x13H\xb9\x1f\x81\xd8ƄCQ\x9a\x96\x90)\xcc\xcb\x06\xaf:\xe2v0\x85%\xa4\xf0#\x9c\xdb\xc7!l\x80\x99FO\xb9\v_\xb9\xa68\r\x90\xa9\xe2\xe7\xa7\x14oܾ\xe8i3\x8aUS\xc4\xd0\xf0\xceƣ\x04\xfb,\xc3Z3v\xb7\x98\xd1\x14\x1f\v\xf2O0qWİ\xfbr\xa6\a\x8c\x10\xb8\xb6\xbbT\x9b\xad\xdf'\x06\xef\x02ߤs&\x13E\x96\x95\x890\xf8\x8fr%?\xa0}\xb7\xe0\xe35\xef\x83N\xdaẖ\xa3\xe9\xe1\xb9\xe9\fo\xc29\x84\xbaHv\x1d\xfb\x88\x9c\xcf%\xef\x03\xa2rF\xe1\xa3D<\xd6g.'?ڊG\x81\xa0o\xcf.\x86&\xfe8\xcc=\xbc\xa9\xf4\x9eFx\vX\x8a\xe6;\x04\x9c\xb3\xea\x0e\xc0}A\x18)4\xb5*F\x91\x9c˾\xaf\x19C\xf74\xe1\x9b\a\xd0\xddv\x06\x8c\x15\xd2\xe1'\x17\xd5w\xda8z\x96=\x1e\x8e\x86`\xb6G\x01\xbbq\xb0f\x005I\xb7GB̉$\x9bO\xa6^\xf5\xa3\x94\xfa&\xb1q\x1fs\xfc\x9e-\x17\xd5$\xf7I\xfd\x143\xdc\xd8\xd6\xe5\xb5;\xf7$E-\x0eb\b\x87\xe4\n\xed\x01`\xc06\xaa\xac\xab\x17\x87\xef\rE\x9dYw@H\x91ݯE\xd5\x1f\x9a~\x06\xa6\\\x06(\x10\xa6;g\xa5\x80\xfa\x81\x9e\xc1\x15\b\xbd2J\x16\x9b\xd8\xebA\xf9\xc9f\xe2\xd1\x11\xd8\xc4\xf7\xcdש\xa1Gi\xfbɦ}\x1bx}\x14\xfb)'\xdd\xe9H\x9d{\xa0lϷ\xa3\x1f\x89T4\xa8\xc4\xc6\xdf\xf8t\x9d\xc3_\xeci\x1aq\xb6o\xf6\xaf\xdc\xd5JU\xfaeB\xae\xa3\xe1v$\x86\xfe\x85\xb1?\x89\x8b\x81N\xd9x'\xe5\x97\xc9M\xcf)\xfeŔq\x857\x1e\x9b\x8eA{)fa\xee\x98ǎ\xb0\xf7\t\xefJY\xf8\xccX\xc5\x1dVWxSc\x91`\x0fX\x8d\x15\xc8\xf5\x9b\xb2x#3\x9b6\x96\xb33\x87\x06\x8e\x83\xae\\\fVx\x13\r/\xd6;\xb8\x84\"\x06\x7fRc\xe5\xe7\xa6\\w\x8bx\xa6\x8c\x85M\x82(\v\x8dʼ@\xa2\xf7,jb\xb8\xb6c\xa4ώ&d\x91\xe2\xfe\xf7u\xd4\x0e\xbb\x92\xba\x8b)Ŭ\b\x9b\xb2<vk\xf5\xf77\xad\xfc\xab\xb5A5\x16/h\xe8H\xba\x1d\x9d\x13NG>\xb7-\xe0\xa7@\x13Ñb\x14\xb5|\x14\xb4\xe0\x18\x88\x8b\xbe\xe2\xda\xfbCK1\xebR\xb9\x8e\x1a\xb8\x04E\xc1x\xb6\x04\xe7\xd5q\x98\arzb{\x12\xdbg\x92:*\xb5\xc3\x16CJ0v\x18\xd17w\xd2\xcbut\x80K\xd8;\x95\x0eǈe\xb9\xd8?\xa4\xfdϿ>\xfb\x9f\xdff\x7f\xc0\x84v\x12f\xdc\xd0\xcdt\xb0\xf5\xacͩ!\xb8\xea\xf6\xe5(\x1f\xf6\xe8.\x19G\xccD\xfdR$[L\xff@]g\xe3\r\xd6\x16\x10\xa7\xccJ\xe6U\x86m\x05\x1ek\xa4F\xf7\x13?-8\f}Q4f\x1c\x8ax\x8b\x1a\x05\xee\x1e\xf9\xe9\x82\\\x81\xfbU/\xe6\xaf\x1c\xce\xce <;;\vcP\xbd\x1a\x7fץ\x8f\x8b\xf0\xdf!\x91\xd9\x02H\x17\xac-\x01\a\xaayQ\x18\x87\xad\x90\xff\x84Ρ\xd3\x1e/\x9f6,\x9d#+!U\f\xb9\xbbǠ'\xbae\xa1\xb2\xdc\xfd>\xef}C\xbb\xd1\xcbZ\xe9R\x1d5y\xf6z\x9f\xd9j\x04\xcfܡtRft\x8eF\xa7k4d\xdf\xc1X\x81{\xbf(P\x92\xb4\\\xae\xdat\a\x8e\x0e\x15\x9e\f\xbf6\xd3vb\xb9\x9eC.\xf6\xb1c]\xd2\xc3\xdf`-2\x8d1\x18Uc\x97i\x95\xc2F\x96\xb5\xbeu\xe5\xa9\xecKX\f\x92\x17\xb3r\x1b\x91\x8d.\xd4=u\x85iU$\xe7\xb9r\xe8\x116\xb1oZ_\x14-Ǥi \xfev˛_\xa8\xdfK\xfb\xe5x\xc0\x92>L\x8e\xc1\x8b\xdd\x00\x87^H\xf7=B\xfbA\xc2o8\xe9\xebu\xb2}?\x14\xa5\xc4\xc8F\x18La\x95l\xdf\ve>HQ\f\x8d\x8a\xd7\xd5w\xbd7u\xdc紩\xb0\xeb2=\xccU\xb7\x01\xd7\"\xb3\x84\xed\xfeӈ̫/\xc4<Sb\xacr\"M\xdf\n\xa9hwjD\xd6W\x19+\xab\x11\xd9\xed\xb7qG\x8b{k\f\xcd\\+e\x90ߪhI\xb1\x11ٕ\xd7\x19ߪ\x13w\x0e\r\x18w\xb0\x99\xfaq\x1e\\\x8c\x00\xa9=(1YH\x1fK\xed\xbc\xbb\xf3\xb4\r\xd1H4\xe9rJ4\xdb\xe1\xc1\x97\xdc%\xb67r\xb20\x86\xd1\x0f\x7f\x12\xfb\x98\xe0\xbf\xcf\xe0\x87?[)\x93\x89\x10\xecw\x1fU\x99\t\x83\xce+\xf4\xbfs\x97'\x99\x82\xd9֡\xbe\xa4O\xed\xe9s\xf97<D;N\xd1\xfa'-\xda}/¦_\xd8t\xbb\xd2Q\x82.l\xaet^&\xae\x17\x87\xe8:+\x93\x1d\xdd\x05\x1fb\xd8\x03Yy\t\a\xfa\xebzy\xc7s:\xe1\x19cm\x1f=\x9fy\xa7n\xaa\xef\xd6c\xe6J\xba\xac\xa6w\xd2NU\xa7,\xeb4\xda\xdf\xf2\x86D{\xfc-/I\x16o\xdd\xf4\x9c\xa6n\x8cy\x1awC\xc49\x01վp\xf6\xf0[\x88\x0eEg\x89O4`\xee\xa2\x1f\xe7\xc1\xe8\xaf;\b\xf4wi\x9b\x91>\x14\xdd7X/\x0e\xe3\xa2w\xfaL$\x86\xd0\xd5Ȑ[\xa3)\xcd\xde\xd5ڬPI\x91\xc9\x0f\xe8\x7f\xb0\xa6\x8dB\x91\x93\xe3\xdb_\xf0^I\x83Qh1\x1a\xf2`\xf2\x82)\xbbw\r\x7f\a\x80\xb1t'\x93x\xc7BiS\x84K\x18}!\x11C\xd8\xfe\xe3a\xf7\x8e\xc1\x03\x1e\xfco\x00\xafQ] ",
+	"x\x9c\xecTMo\x1b7\x10=\x93\xbfb\xca\x13\x89Ҳ\xe5\xde\\\xb8\x80P\xb8@\xdb(\t  ȕю$z\xb9K\x81\xa4֒\x83\xfc\xf7`\xb8\x1fZIv\x8c\xdcs0\xbc\xd4\x1b\xbey\xf3f\x86s\xb3\x85\xc2K\xce6&~2n\x87pw\x0f\x15\xa6\x8d/dCg\r\x11\xdd\n\xf2w\x84\xa5\xaf\x93\xb1ul1\xa583E\xf1?\x1e\xe2\xac.\xf2\xf58\xba_\xe2!\xea\xee\xa6\xe6\x8c\xd1\x19V>\xa0Yn\xa4\xd5P\xe2\xa1c7\xe9\xe3.\xc9|\xee\x12\x99$\xad\"~F\x01\x9c)\xceٓM\x9b\x11=g\xac\xa2#\x95\xb0t\xbeF\xce\xd8\xca\ab\xbeѰ4\u0381\t\xeb\xbf\xfd\xaeNp\x05S\r\xb7$\x82U]\xb6\x1c\x80\x8dq\xb3\xb0\x9eQ6\r\xe7?\xfd>\xcd\n\xe8\xafj%\x98\xf8_\xf4\xf5H\x84\xf8*`2i\xab\xc8\xf5Uf+K\r%t\xa1\x93\t\x88\xbbc\x8cI\xb2T\x1d\xa6\xe0\xd1\xdbZ\n-\x14\xe1\xe2\x9b ?\xe3;\x1b\xd3(Ø\xd5٘\xe8\x7ff!{8\xab\xccv\x14\xcc\x19st\xcc\x1c\xbd)e\x16\xdc\x1b2K\xf2FAm*\x82\x9ash:@\xd5\x19r\xdb7cha\xa9\xa1ɖf\xea\x88u\x81\x01\"\xa6\x85\xf3I\x96\xb5\x86R\xbd\x866\xb5\x86u\xf7-\x1a\x91mf\xd7\xd7\xff\xfc\xfby\xfep\xd7G\x01\x9aԎ\\\xf0\x0eV\xce?\xc1\x06\x03\xc9fa\x10\xd7\x11\x17~\x8e1\x9a5\xca*s90\xdb-օ\f}\a\x1du\x90\xa6\t\x97\xe9\x8d):\x19\xd42\x97hW\xb2\xf7\xa2\x9d\xa8\xbf`\x9a\x81\x17˻\xf4\xba7\xe3\x92\xe8\xfe\x1e\xfeh\x99ޢ\xeaz3\xec\f\xcd@\xbeH屟\xb5\xb0\x19,<N\xfc\xa9\xb2+\x98\xaa\xae\xf6q\xaf4\xf4+T\x9eH9Y\x15V`\xba0\xfa\x97\xaf?\xf65`څz\xd8\xf2Koi\xe1\x036\x18\"\xce\xcf\xf7\xbe\xa4\xbc\xe40\xef4\x94\xf9\xd5\xd8\x1fy\xf6\x99f\x18t8\x7f\xbae\xd3N)e1\xf1×ǋ\x06\ue277\x03\xfae\xb9|\x12`?\xb8\\\x9e\xad9\tط\x19l|\xa8\xb6\xe90J\x10\xed3R\xdbn\x14\xa1\xef}z1\xe0\xb7\x1c\xc0\xd9\xe3.\xa6\x05\x06k\x9c}\xc6b\x1c\x95\x02\x9a\x8a\xa6\xab\xfd\x82\xa7`\x13Jq,\xbc\xf0R\xbc\xfe\x9a\x9d\xdej']\xb4/\xfa\xc2V[\x87\x8b\x14l\xbd\xd6 4d\x1a6.\x11Nuuj\xd4%\xb1\xfaS\xf4\x1bs\x06\bř\xe2\x8a\x7f\x1f\x00\xa4\x849#",
+	"x\x9c\x94VKo\xdc6\x10>K\xbfb\xa2\x1eJ\xa2\xca:\xeeq\r\xb5p\x8c\x04\b\xea\xd8Ew\x81^z\xe1J#-m>\x14\x92\xaa\xed\xfc\xfa\x82\x0f\xad\xb8\xbb\x8e\x81^,\x93\x9a\x99o\xbeo\x1eڏB\xb7\x8f\xd0iR\x16\xccn\xb8\x1c\x05n\x9c\xe1j\x80u\x03\x12\xdd^w\xa4,\n\xde\x13\xdb\xea\x11k\xa8vޣ\xaa\xa1\x8ao+\n\xab\x15T\xa4\xf2\x0ff\x86I\xa2rwL\xa2\x056\x8e\xa8:R\xadV\xab\x8a\u0083\xe6\x8ax\xbf\xe8@\xab\xb2\xa0\x01\xf4\x14\xee\xb36\x929\x87\x06Z\xa1\x15B\x1f\xce\x1f=,\x19\xd0m\x84v\xa4\xb2(\xfa\x8aR\xd8M=-ˢeB\xfc\xcd\xdd\xfe\xda\f\xb7ܺ,\x1a3\x83\xadˢ8\xf1\x84N\x7fEkـ\xc1\x02\x98MG\xb0\x18\xd2'\x95\x8fYQ\xea\xd3,\x8b%\xa9u\x03\xf7\xbb\al]J/hW\b\xae\x10\x00`\xbdn\xe0\x83\x17\xcc~\x92\xa3{\tgg&,\x8b\xa2\xc3\xd1\xed3\x93\xdd\xd4\xc3좸(\x8bB\x8f\xf6\xe4\x86\xd9A\xe57>\xb2\xe29\xbf\xb2\x88\x91\x1a\xd8\xe0\xb7\tU\x8b1/\x7f\xef\xe35p?\xa2aN\x9b-\xdb\t\x04\x9dN\xd6\x1b\x84\xf0\xa7\x16\x06\xffEc\xf1\xdaZ>\xa8\xfb3s\xe6\xfe\x9c\x82\x8cAO/\xfa\xf6eD_\xd8uSѲ\br\x15\xb1\xf6\x1b\xfc\x96\xa5\xea\x05\x85\x0e\x05\x0e\xcc\xe1V\x13_\xbb+\x98\x95j\xa0g\xc2b\xf0V\xf8t\xeb\x05=\xa7y\x88K\xaa\x7fT\x80\x8b\xd27\x10\x1e\xbf\xc0eY,\xea\x1f\xb4\xa7KP\xbb\xd5Y\\i\a\xdf\x1d\x05\x91v\b!\xee&\xb9C\x03\xefÁ\x82\xe4\xea+{&\x1fj\xf8\x95\x82\xc1\x11\x99#)P\x0e~\xec=\x8b\xc0U\x87*/Vl\x81\x14&\xa3\xe2K\\Q\x1a\xb8g\xed\x9ey\xee\xc4c\xc8\xd3\x03\xad\x1b8t\xf3N<V\x14d\xec]\xef^\\\\\xc0v\xcf-<i\xf5\xb3\x83'm\x1eaR\x8e\v\xb8\xb9\xfd\x02\xf8̭\xb3\xabd\xc7\xfbH\x9b\xedP\xc0\xbb&X\xb4ZJ\xa6:/\xff\xad\xbf\x0f\xa8Y\xaa?A\x98\xf4\xc5\xcf\x0f\xf3z\xb9;\x88PC\xa8\x10\\\\@൴\x04I\xab\x83\xc4\xfa\xf1~\x19\xebH\xe7x\x8dX\xfe\x1d\xe17\xf8\x102ɢ\xbc\xe9\xb4l\x9b\x1a\xaa:\x02\x85?\xa9xo\xd5.\x95\xe0K\xa8\x1ev\xf3\x9e\x90v8\x8d\x90Q\xa2\x11\xc3凉\xfc\xaf\x86y\xa5\xf7bW4\x10\x9f\xa9\x85\xa3\xf3)\xf6\xb1\xe9{\xb8<\xc6\xfa1\x86\f\xb8v\xf0\xff?\xed\xb9@\"\x03\xb6\xdf\xec\xd2Ϡ\xea\xee{O+\xde\xfa\xeb(O\x03\x12\x14>;\xf8=+m\xb4\xc9\xe8KPLbP \xe9\xec!g\xdfx\xddj\xe5\xb8\n\xe38\xdb$)\xedV\x13I\xe7t\xd2\xf0\xd6\x10ǧ\x86\x90⻐~\x9d/\x8082\xc9+\xac\xb2=\xb3\x7f\xe0˜\r\x05\xb7GEb6a\xcb{\r\x0e\x8db\x0fLû\x9e\x1b\xeb`\xcf\xec\rk\xf7\xd8\xfd\x85v\x12\xee\x9cif\xdbf\x86\xbe\xff\xab8\x1aiK\xceY\xa47I\x94\x03\x1cs\xe4\x92\xd6pT\xb7\xecM\"V\x9c'\x90\xc2.\xe1\x02\xa3\x1b=)\x17\xa6$\x85\xbc\x9eY\x129Ǌ\x0f\n(,\xf2\x9e\xf8\xaf\xc3[\x82\x9d \xd6\x19\x8b\x195\"D9N\xb9\x9c\x19\xa44\"\xfe\x0f@h\xf9\x7fY\xd1\xf2\xbc\xd7\x0e_\xa1\x13\xa7W\xe6bI\xa0J\xeb\xc8:f\xc2\xce\x16i\xc8\xfd\xaa˨h\x83\xac\xdd\x13^{~\x87\x19\xe2ˆ\xcag'\x04;jڸ\x92R\xe3_\xa5\x1e\xbf:\xb2\xa8\xe8\xd5l\x90s|}+13,\xcbmF~w@>\x01\xa2\xafn\xad\xf4\x1b\xe7a\xb2n\x83\x863\xc1\xbfc\x97\x89e\x9dA&k\x88Ox2\xdc!iu\x87\x94\x96\xb4,o\xb4A\xffE\xba\xd5-\x13q\x8f\xdf|\x9eT\xeb\xb8\xf6\x1f\x00\xde\xdfqA>=\xb78\xfa\x1b0\x8c[\xa4\xf17\xa7{\x19ö\xca\x1cJZ\xfe7\x00}\xa2\x0f\x18",
+	"x\x9cT\x8e\xb1j\xf40\x10\x84k\xe9)\x06U\x12\x18c\xf8\x7f\b\xe4H\xe0\x1e\xe0*\xb7i6\xf6\xda'XI\x8eV\x86\x90\xa7\x0f\x82\xbb\"\xe5\xb7;\xc3|7V\xa5\x9d\xb1\x16o\r\xe9\x1c\xd3!<\xb7\x1a\xf3\x8e\xd77$n\xf7\xb2zk\x8cvT\x96\r\xa4\x8f?\xe9\xedl\xf4)\x8cʇ\xd0\xc23\x7fy\x87\xcbGv\x03\xdc\x05.Xc\xe2\xe6\x15\x1a\x7f\x18\xef\xf8?\rP\xf0\xf7,qa?\r\xf8\xf7\x120\x8ep\xe38\xba\x01\x1a\xac\t֚3ǒ\xff\xae\xa7\x8eO\xd7EJfk\x8c\xf4\xa3Dm\xbe{\xf5\xb1\x85D\x90\x1e1\xaa\xfb\xb5\xf9)\x80\xea~&\xceM\xb1\x95ʴ\xdc=\xd5}\x80\x80\x8e\x83\xf3\xda)\xf4v\x82r\xbb>\xc3^\x825\xc1\x06\xfb;\x00d\xa3U\xf4",
+	"x\x9ct\x91?o\x830\x10\xc5g\xfbS\x9c\x98l\x89\xa1C\x87*\x15C\x87v\xea?\x89t\xcbr\x81#qcl\xe23H\xf9\xf6\x15\x90\x84\x84&#\xf8\xde\xfb\xdd{\xf7\xd5P\xc0\xe8\xc3\x12ז\xa0\xf4J\n,\xcb\xd3_XdPS\xdc\xfaR9\xac)\x85&P\x91\x82?>3`\xfcn\xe3\xc5\x1b\x98\xea\xd3\xd8\xd7\x0e\xadz\xd0\xfa\x19\x98l\xa5\a\xcb\x17f\xb3qw\x8d\v\xb4\x96S\xc0\xab\xa9k\xffa\x04\x90\xf3C\xbd\xf6\x16\x90\x7f\x96oOg\x86\x14\x81:\nL\xd7 \xbe \xcd͏\x82\x0fl\xb4\x94\x029\x8f\xc1\xb8ͅ@\n\xb1\xee?sڷ\xe4\n\x82\xc2zG\x80MC\xae\xcci\xaf\xfax\xfdF\xa6n,\x8d\xf2\x14\x92\xc5j\x82$Z\n1\x8cM\xa5uh[bh\x9dٷ\x04\xecC\x84\xca\a\xc2b\xab\x86~\xa5\xe8\xb9\x13%Y9\x80\xe4X\xf0yM\xb4f\xe3ީ\x8a\xeaQ\xa70C0Y*\xa2ڥХ\xd0A\x96\rb\r;:\xf0H\xfc\xf5Ʃ\x04\x12\xdd/\xa8\xe5?\xe2ʍE\u008d(\xf3\"'\xd7S\x8e\xe1b}\x8e\xf1h\x8b\fn\nq\xbc\xad\xbe\x97\xb8\x7f\x9c\x05\x1d\f\xcf;K\xa1\xa5\x96\x7f\x03\x00֯\xebP",
+	"x\x9c\x8cS\xcdn\x9c0\x10\xbe\xefS\x8c|2\xea&j{L\x94S\x95\xaa=\xa4\xa9ʡ\x97^\xbc\xe6\x83u16\xb5\x87\r\xbc}eSإ\xabH\x91\xb90\xdf\xdfx\x18\xca)2:\xaa\xbc\xdc\x11\x11!\x04\x1f\xbe\r\xdd\x01\x81\xee\x1e\xe8\xfd.W;5\xfe\x80\x9e\xb4E\xf5|\xf8\r\xcdq\x06\x13\x166\xc0'?8>\x83\x11\xfc\xf4\x8a6\x1bǩ;x\x9b+\xd6D\x9e\xd3\x1a\xf0s\xcfƻ\\\xef\xc0G_I\x15\x9a\xb8\xcfpz|?\xb7\xf0\xa4z\xd2\xd6;\xac\xc8\xe0ZL\xa8Vǥ\x9e\xf4T\xfb\x00\xa5\x8f\xd2\xecI\x85\xe6l\xf7\x8f@\a4\xc6ş\x86\x8f%\xfeHqs#\n2\xf5ge#\xe4b\xac\xfa\x1e.\xf7Sܓ\xf6\x8e\x8d\x1bPl\xacڔ\x9e\xfcj\xe3\xaa\xec\xf4 \xb6\fS\xcbv\x1b\x9f\x8eS\x1d\x16)\xc6\xd2\x1a\r\xf9qO\xedV\x9b\xceI\xd9\xff\x89-\xbd\xa3\x0f[\xe6\x1b\x13^\xb5\x17b\x83ly\xf9\x13(\xfe>\xb0L\xae{:)[쮹\xcb\xdcL|\xecz\x9e\xd6y^\xe8\x85\xd8/\xb4\xe2,L\x84\xfcR\xcckQ\xa1F\b\xa8\x1eG\x8d\xbc\x1e_\x94\xab,\xc2Œ`\xd4\xe7;K\xb12ɸUN\x1dbT\r\xeeH\xd0\xed-a\xd4\xf3\xd2\x17\xd4\a\xe3غ\xd5 A\xf1\xe8_JV\xba\xbd\xecd\x88\b_\x1d#\x84\xa1\xe7\xeb.V\x03\xf1\xcb\x05h\x98\x13*2\v\xff\x9e0\x1a6\xae\x11W\x81\x11\xb6\xce\xe8eX\xb4@\x9f\xee8\xff=ԀK\xebY\x8a\x17eX\x14\xbbb\xf7w\x00q\x01\a\x02",
+	"x\x9c\x94\x90A\xaf\x820\x10\x84\xef\xfd\x15\x1bNp\xe2\xfe\x92wy/܌\x1a\xffA\x85\x95T\xebn\xd3n\x0f\xfe{CQ\x100F\xaeۙo\xa6\xb3e\x7f\xd5\x16~~aw<c-P[&\x84\x86s\x05\x00`\xc2?\x93\x18\x8a\xd8IN\xda\x06|\xdc\xff<\xea\xcb\xf4\x18\x84݆\xd9\x19j\xe7\xea\x03J\xf44\xbd\xca\xcd%j\xd6w\xc8T\xa1T\xc5\xcb.\xda9\xa4f\xefY8\xef\xa5E\xd7\x0f\xca\x12\"ŀ\r\x18\x02\xc3VS;\xe5V\xdcC_\xbf\xf0\r\xf9\xcd\xcf\xc5\xc7Y\xe9\xe7kJ\x18\xc6X\x81\x1f<\x03{\xb6\xdf23YR\xe0\xb8\xe7\x8a\xc4\xd1\xf41\xb2P\xf7\x01\x00\xe4\x0e\xa5\x04",
 }
 
 var coreFiles = []string{"io/00_Object.io", "io/01_Call.io", "io/02_Sequence.io", "io/04_Exception.io", "io/05_Number.io", "io/06_List.io", "io/10_Map.io", "io/12_Block.io", "io/13_Message.io", "io/14_OperatorTable.io", "io/16_System.io", "io/17_Stop.io"}
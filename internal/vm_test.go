@@ -1,9 +1,12 @@
 package internal_test
 
 import (
+	"bytes"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/zephyrtronium/iolang"
 	"github.com/zephyrtronium/iolang/testutils"
 )
 
@@ -49,6 +52,20 @@ func TestLobbySlots(t *testing.T) {
 	testutils.CheckSlots(t, vm.Lobby, slots)
 }
 
+// TestSnapshot tests that restoring a snapshot reverts the Lobby's slots.
+func TestSnapshot(t *testing.T) {
+	vm := testutils.VM()
+	old, _ := vm.GetLocalSlot(vm.Lobby, "Protos")
+	s := vm.Snapshot()
+	vm.SetSlot(vm.Lobby, "snapshotTest", vm.NewNumber(1))
+	vm.SetSlot(vm.Lobby, "Protos", vm.Nil)
+	vm.Restore(s)
+	testutils.CheckSlots(t, vm.Lobby, []string{"Lobby", "Protos"})
+	if v, _ := vm.GetLocalSlot(vm.Lobby, "Protos"); v != old {
+		t.Errorf("Protos is %v after restore, want %v", v, old)
+	}
+}
+
 // TestLobbyProtos tests that a new VM Lobby has the protos we expect.
 func TestLobbyProtos(t *testing.T) {
 	vm := testutils.VM()
@@ -120,6 +137,7 @@ func TestCoreSlots(t *testing.T) {
 		"OperatorTable",
 		// "Path", // TODO: coreext
 		// "Profiler",
+		"Random",
 		"Return",
 		// "RunnerMixIn", // TODO: coreext
 		// "Sandbox",
@@ -155,3 +173,49 @@ func TestAddonsSlots(t *testing.T) {
 func TestAddonsProtos(t *testing.T) {
 	testutils.CheckObjectIsProto(t, testutils.VM().Addons)
 }
+
+// TestAddMethod tests that AddMethod installs a CFunction on a Core proto.
+func TestAddMethod(t *testing.T) {
+	vm := testutils.VM()
+	seq := vm.CoreObject("Sequence")
+	if seq == nil {
+		t.Fatal("no Core Sequence")
+	}
+	if vm.CoreObject("NoSuchProto") != nil {
+		t.Error("CoreObject found a nonexistent proto")
+	}
+	vm.AddMethod(seq, "testAddMethod", func(vm *iolang.VM, target, locals *iolang.Object, msg *iolang.Message) *iolang.Object {
+		return vm.NewNumber(1)
+	}, iolang.SequenceTag)
+	defer vm.RemoveSlot(seq, "testAddMethod")
+	c := testutils.SourceTestCase{Source: `"abc" testAddMethod`, Pass: testutils.PassEqual(vm.NewNumber(1))}
+	t.Run("Sequence", c.TestFunc("TestAddMethod"))
+	c = testutils.SourceTestCase{Source: `Sequence getSlot("testAddMethod") performOn(1)`, Pass: testutils.PassFailure()}
+	t.Run("WrongTag", c.TestFunc("TestAddMethod"))
+}
+
+// TestOnExit tests that exit hooks run in LIFO order when the VM stops, even
+// if one of them panics.
+func TestOnExit(t *testing.T) {
+	vm := iolang.NewVM()
+	var stderr bytes.Buffer
+	if err := vm.SetStderr(&stderr); err != nil {
+		t.Fatal(err)
+	}
+	var order []int
+	vm.OnExit(func() { order = append(order, 1) })
+	vm.OnExit(func() { panic("bad hook") })
+	vm.OnExit(func() { order = append(order, 3) })
+	if _, stop := vm.DoString("System exit", "TestOnExit"); stop != iolang.ExitStop && stop != iolang.NoStop {
+		t.Errorf("wrong stop %v", stop)
+	}
+	if vm.IsAlive() {
+		t.Fatal("VM still alive after System exit")
+	}
+	if !reflect.DeepEqual(order, []int{3, 1}) {
+		t.Errorf("wrong hook order: want [3 1], got %v", order)
+	}
+	if !strings.Contains(stderr.String(), "bad hook") {
+		t.Errorf("panic not reported: stderr is %q", stderr.String())
+	}
+}
@@ -8,9 +8,8 @@ Act1 (concurrency via actors and futures), and Lua (small and embeddable
 implementations). It was originally developed in C by Steve Dekorte.
 
 Currently, this implementation is focusing primarily on becoming a fully
-fledged interpreter. A read-eval-print loop exists, primarily for integration
-testing. There is no file interpreter, so written Io programs are not yet
-executable.
+fledged interpreter. The io command runs a read-eval-print loop, primarily for
+integration testing, or executes a script file given as its first argument.
 
 The interpreter can easily be embedded in another program. To start, use the
 NewVM function to create and initialize the interpreter. The VM object has a
@@ -142,6 +141,8 @@ GitHub repository at https://github.com/IoLanguage/io for code samples.
 package iolang
 
 import (
+	"io"
+
 	"github.com/zephyrtronium/iolang/internal"
 )
 
@@ -198,12 +199,25 @@ type Exception = internal.Exception
 // locals of the innermost currently executing block.
 type Message = internal.Message
 
+// Output is a writer for a VM's standard output, shared by all coroutines in
+// the VM. It writes through to its underlying writer by default, but it can
+// be set to buffer writes.
+type Output = internal.Output
+
+// A RecordedSend describes one message send observed while recording, as
+// returned by vm.RecordedSends.
+type RecordedSend = internal.RecordedSend
+
 // Scheduler helps manage a group of Io coroutines.
 type Scheduler = internal.Scheduler
 
 // A Sequence is a collection of data of one fixed-size type.
 type Sequence = internal.Sequence
 
+// A Snapshot is a shallow record of the slots of a VM's Lobby, created by
+// vm.Snapshot and reinstated by vm.Restore.
+type Snapshot = internal.Snapshot
+
 // An Fn is a statically compiled function which can be executed in an Io VM.
 type Fn = internal.Fn
 
@@ -247,6 +261,11 @@ func NewVM(args ...string) *VM {
 	return internal.NewVM(args...)
 }
 
+// NewOutput creates a new unbuffered Output writing to w.
+func NewOutput(w io.Writer) *Output {
+	return internal.NewOutput(w)
+}
+
 // ForeachArgs gets the arguments for a foreach method utilizing the standard
 // foreach([[key,] value,] message) syntax.
 func ForeachArgs(msg *Message) (kn, vn string, hkn, hvn bool, ev *Message) {